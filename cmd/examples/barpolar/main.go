@@ -0,0 +1,65 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	directions := []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+	// Create a wind rose: one barpolar trace per wind speed, stacked by the
+	// polar bar mode
+	speeds := []struct {
+		name        string
+		frequencies []float64
+		color       string
+	}{
+		{"< 5 m/s", []float64{7.5, 4.2, 3.1, 2.8, 4.4, 6.3, 8.9, 6.1}, "#fde725"},
+		{"5-10 m/s", []float64{4.9, 2.1, 1.6, 1.4, 2.6, 4.8, 7.2, 4.5}, "#35b779"},
+		{"> 10 m/s", []float64{1.8, 0.6, 0.4, 0.3, 0.9, 2.2, 3.9, 1.7}, "#31688e"},
+	}
+	for _, speed := range speeds {
+		trace := graph_objects.NewBarpolar()
+		trace.Name = speed.name
+		trace.R = speed.frequencies
+		trace.Theta = directions
+		trace.Marker = &graph_objects.BarMarker{Color: speed.color}
+
+		if err := fig.AddTrace(trace); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// Set a typed layout with a polar subplot, north at the top
+	fig.Layout = &graph_objects.Layout{
+		Title:  &graph_objects.Title{Text: "Wind Rose"},
+		Width:  800,
+		Height: 700,
+		Polar: &graph_objects.Polar{
+			BarMode: string(graph_objects.BarModeStack),
+			BarGap:  graph_objects.Float64(0.05),
+			RadialAxis: &graph_objects.RadialAxis{
+				TickSuffix: "%",
+			},
+			AngularAxis: &graph_objects.AngularAxis{
+				Direction: graph_objects.AngularDirectionClockwise,
+				Rotation:  90,
+			},
+		},
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Create sample stock data
+	dates := []string{
+		"2024-01-01", "2024-01-02", "2024-01-03", "2024-01-04", "2024-01-05",
+		"2024-01-08", "2024-01-09", "2024-01-10", "2024-01-11", "2024-01-12",
+	}
+
+	// Build an OHLC trace and switch it to candlesticks
+	ohlc := graph_objects.NewOHLC()
+	ohlc.X = dates
+	ohlc.Open = []float64{152.0, 153.0, 151.5, 154.0, 155.5, 156.0, 157.5, 158.0, 157.0, 160.0}
+	ohlc.High = []float64{153.0, 154.0, 153.5, 156.0, 156.5, 157.0, 158.5, 160.0, 159.0, 162.0}
+	ohlc.Low = []float64{151.0, 150.5, 151.0, 153.5, 154.0, 155.0, 157.0, 157.0, 156.5, 159.5}
+	ohlc.Close = []float64{152.5, 151.0, 152.5, 155.0, 154.0, 156.5, 158.0, 157.5, 158.5, 161.5}
+	ohlc.Name = "Stock Price"
+
+	candlestick := graph_objects.NewCandlestickFromOHLC(ohlc)
+	candlestick.WhiskerWidth = graph_objects.Float64(0.4)
+
+	// Style the candles
+	candlestick.Increasing = &graph_objects.CandlestickDirection{
+		FillColor: "#00C805", // Bright green
+		Line: &graph_objects.CandlestickDirectionLine{
+			Color: "#00A004",
+			Width: 1,
+		},
+	}
+	candlestick.Decreasing = &graph_objects.CandlestickDirection{
+		FillColor: "#FF3319", // Bright red
+		Line: &graph_objects.CandlestickDirectionLine{
+			Color: "#D42A14",
+			Width: 1,
+		},
+	}
+
+	// Add trace to figure
+	if err := fig.AddTraces(candlestick); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Stock Price Candlestick Chart",
+		},
+		"xaxis": map[string]interface{}{
+			"title":     "Date",
+			"type":      "category",
+			"tickangle": -45,
+			"rangeslider": map[string]interface{}{
+				"visible": false,
+			},
+		},
+		"yaxis": map[string]interface{}{
+			"title":      "Price ($)",
+			"tickformat": ".2f",
+		},
+		"width":  1000,
+		"height": 600,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
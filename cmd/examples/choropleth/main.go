@@ -0,0 +1,66 @@
+package main
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Load the region boundaries from disk. Run from the repository root,
+	// e.g. with make run-choropleth.
+	regions, err := graph_objects.LoadGeoJSON(filepath.Join("cmd", "examples", "choropleth", "regions.geojson"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create a new figure
+	fig := figure.New()
+
+	// Create a choropleth trace with the request volume of each region,
+	// matched to the region features by their name property
+	choropleth := graph_objects.NewChoropleth()
+	choropleth.Name = "Requests"
+	choropleth.GeoJSON = regions
+	choropleth.FeatureIDKey = "properties.name"
+	choropleth.Locations = []string{"UK and Ireland", "EU West", "EU Central", "EU North", "EU South"}
+	choropleth.Z = []float64{18200, 24500, 31800, 9700, 14300}
+	choropleth.ColorScale = "Blues"
+	choropleth.ColorBar = &graph_objects.ColorBar{Title: "Requests/day"}
+	choropleth.Marker = &graph_objects.ChoroplethMarker{
+		Line: &graph_objects.MarkerLine{Color: "white", Width: 1},
+	}
+	choropleth.HoverTemplate = "%{location}: %{z:,} requests<extra></extra>"
+
+	// Add trace to figure
+	if err := fig.AddTrace(choropleth); err != nil {
+		log.Fatal(err)
+	}
+
+	// Set a typed layout with a geographic map zoomed to the regions
+	fig.Layout = &graph_objects.Layout{
+		Title:  &graph_objects.Title{Text: "Daily Requests by Region"},
+		Width:  800,
+		Height: 700,
+		Geo: &graph_objects.Geo{
+			Scope:         graph_objects.GeoScopeEurope,
+			Projection:    &graph_objects.GeoProjection{Type: graph_objects.GeoProjectionMercator},
+			FitBounds:     graph_objects.FitBoundsLocations,
+			ShowLand:      graph_objects.Bool(true),
+			LandColor:     "#f2f2f2",
+			ShowCountries: graph_objects.Bool(true),
+			CountryColor:  "#c8c8c8",
+		},
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
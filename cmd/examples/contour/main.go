@@ -0,0 +1,72 @@
+package main
+
+import (
+	"log"
+	"math"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Sample z = sin(x) * cos(y) on a grid
+	var xs, ys []float64
+	for i := 0; i <= 40; i++ {
+		xs = append(xs, -math.Pi+float64(i)*math.Pi/20)
+	}
+	for i := 0; i <= 30; i++ {
+		ys = append(ys, -math.Pi+float64(i)*math.Pi/15)
+	}
+	z := make([][]float64, len(ys))
+	for i, y := range ys {
+		z[i] = make([]float64, len(xs))
+		for j, x := range xs {
+			z[i][j] = math.Sin(x) * math.Cos(y)
+		}
+	}
+
+	// Create contour trace
+	contour := graph_objects.NewContour()
+	contour.Z = z
+	contour.X = xs
+	contour.Y = ys
+	contour.ColorScale = graph_objects.ColorScaleRdBu
+	contour.Contours = &graph_objects.Contours{
+		Start:       graph_objects.Float64(-1),
+		End:         graph_objects.Float64(1),
+		Size:        graph_objects.Float64(0.2),
+		Coloring:    graph_objects.ContourColoringHeatmap,
+		ShowLabels:  graph_objects.Bool(true),
+		LabelFormat: ".1f",
+	}
+	contour.Line = &graph_objects.ContourLine{
+		Width:     1,
+		Smoothing: graph_objects.Float64(0.85),
+	}
+
+	// Add trace to figure
+	if err := fig.AddTraces(contour); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "sin(x) cos(y)",
+		},
+		"width":  800,
+		"height": 600,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	stages := []string{"Visit", "Sign up", "Activate", "Trial", "Purchase"}
+
+	// Create funnel traces for two acquisition channels
+	organic := graph_objects.NewFunnel()
+	organic.Name = "Organic"
+	organic.Y = stages
+	organic.X = []float64{12000, 3100, 1900, 720, 260}
+	organic.TextInfo = "value+percent initial"
+
+	paid := graph_objects.NewFunnel()
+	paid.Name = "Paid"
+	paid.Y = stages
+	paid.X = []float64{8000, 2600, 1200, 410, 150}
+	paid.TextInfo = "value+percent previous"
+	paid.Connector = &graph_objects.Connector{
+		FillColor: "rgba(255, 127, 14, 0.2)",
+	}
+
+	// Add traces to figure
+	if err := fig.AddTraces(organic, paid); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Conversion by Channel",
+		},
+		"width":  900,
+		"height": 600,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
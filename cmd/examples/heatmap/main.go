@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Create sample p95 latency data by service and hour
+	services := []string{"api", "auth", "search", "checkout"}
+	hours := make([]string, 24)
+	for h := range hours {
+		hours[h] = fmt.Sprintf("%02d:00", h)
+	}
+
+	latency := make([][]float64, len(services))
+	for i := range services {
+		latency[i] = make([]float64, len(hours))
+		for h := range hours {
+			// Daily traffic peak around 14:00
+			peak := math.Exp(-math.Pow(float64(h-14)/4, 2))
+			latency[i][h] = math.Round(80 + 40*float64(i) + 120*peak)
+		}
+	}
+	latency[2][3] = math.NaN() // missing sample
+
+	// Create heatmap trace
+	heatmap := graph_objects.NewHeatmap()
+	heatmap.Z = latency
+	heatmap.X = hours
+	heatmap.Y = services
+	heatmap.ColorScale = graph_objects.ColorScaleYlOrRd
+	heatmap.ZMin = graph_objects.Float64(0)
+	heatmap.ColorBar = &graph_objects.ColorBar{
+		Title: "p95 (ms)",
+	}
+	heatmap.TextTemplate = "%{z}"
+	heatmap.XGap = 1
+	heatmap.YGap = 1
+
+	// Add trace to figure
+	if err := fig.AddTraces(heatmap); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "p95 Latency by Hour",
+		},
+		"xaxis": map[string]interface{}{
+			"title": "Hour",
+		},
+		"width":  1200,
+		"height": 500,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"log"
+	"math/rand"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Generate request sizes and durations
+	r := rand.New(rand.NewSource(7))
+	sizes := make([]float64, 5000)
+	durations := make([]float64, 5000)
+	for i := range sizes {
+		sizes[i] = r.ExpFloat64() * 40
+		durations[i] = 20 + 0.8*sizes[i] + 10*r.NormFloat64()
+	}
+
+	// Create 2D histogram trace
+	hist := graph_objects.NewHistogram2d()
+	hist.X = sizes
+	hist.Y = durations
+	hist.XBins = &graph_objects.Bins{Start: 0, End: 200, Size: 10}
+	hist.NBinsY = 30
+	hist.ColorScale = graph_objects.ColorScaleViridis
+	hist.ColorBar = &graph_objects.ColorBar{Title: "requests"}
+	hist.XGap = 1
+	hist.YGap = 1
+
+	// Add trace to figure
+	if err := fig.AddTraces(hist); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Request Duration by Size",
+		},
+		"xaxis": map[string]interface{}{
+			"title": "Size (KB)",
+		},
+		"yaxis": map[string]interface{}{
+			"title": "Duration (ms)",
+		},
+		"width":  800,
+		"height": 600,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"log"
+	"math/rand"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Generate correlated samples
+	r := rand.New(rand.NewSource(42))
+	xs := make([]float64, 2000)
+	ys := make([]float64, 2000)
+	for i := range xs {
+		xs[i] = r.NormFloat64()
+		ys[i] = 0.6*xs[i] + 0.8*r.NormFloat64()
+	}
+
+	// Create 2D histogram contour trace
+	density := graph_objects.NewHistogram2dContour()
+	density.X = xs
+	density.Y = ys
+	density.XBins = &graph_objects.Bins{Start: -4, End: 4, Size: 0.25}
+	density.YBins = &graph_objects.Bins{Start: -4, End: 4, Size: 0.25}
+	density.HistNorm = string(graph_objects.NormalizationProbability)
+	density.ColorScale = graph_objects.ColorScaleBlues
+	density.ReverseScale = graph_objects.Bool(true)
+	density.Contours = &graph_objects.Contours{
+		Coloring:   graph_objects.ContourColoringFill,
+		ShowLabels: graph_objects.Bool(true),
+	}
+
+	// Overlay the raw samples
+	samples := graph_objects.NewScatter()
+	samples.X = xs
+	samples.Y = ys
+	samples.Mode = string(graph_objects.ModeMarkers)
+	samples.Name = "samples"
+	samples.Marker = &graph_objects.ScatterMarker{
+		Size:  2,
+		Color: "rgba(0, 0, 0, 0.3)",
+	}
+
+	// Add traces to figure
+	if err := fig.AddTraces(density, samples); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Sample Density",
+		},
+		"width":  800,
+		"height": 700,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Create icicle trace from flat arrays, with the teams as children of
+	// their departments
+	icicle := graph_objects.NewIcicle()
+	icicle.Labels = []string{"Company", "Engineering", "Sales", "Platform", "Product", "EMEA", "Americas"}
+	icicle.Parents = []string{"", "Company", "Company", "Engineering", "Engineering", "Sales", "Sales"}
+	icicle.Values = []float64{0, 0, 0, 42, 35, 18, 26}
+	icicle.TextInfo = "label+value+percent root"
+	icicle.Tiling = &graph_objects.IcicleTiling{Orientation: "v"}
+	icicle.PathBar = &graph_objects.PathBar{Side: "bottom"}
+
+	// Add trace to figure
+	if err := fig.AddTraces(icicle); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Headcount by Team",
+		},
+		"width":  900,
+		"height": 500,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
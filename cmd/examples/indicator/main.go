@@ -0,0 +1,104 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Requests today, with the change relative to yesterday
+	requests := graph_objects.NewIndicator()
+	requests.Mode = "number+delta"
+	requests.Value = 128400
+	requests.Title = &graph_objects.IndicatorTitle{Text: "Requests today"}
+	requests.Number = &graph_objects.IndicatorNumber{ValueFormat: ",d"}
+	requests.Delta = &graph_objects.IndicatorDelta{
+		Reference:   graph_objects.Float64(119800),
+		Relative:    graph_objects.Bool(true),
+		ValueFormat: ".1%",
+		Position:    graph_objects.DeltaPositionBottom,
+	}
+	requests.Domain = &graph_objects.Domain{X: []float64{0, 0.3}, Y: []float64{0.55, 1}}
+
+	// Error rate, where a decrease is good news
+	errorRate := graph_objects.NewIndicator()
+	errorRate.Mode = "number+delta"
+	errorRate.Value = 0.0018
+	errorRate.Title = &graph_objects.IndicatorTitle{Text: "Error rate"}
+	errorRate.Number = &graph_objects.IndicatorNumber{ValueFormat: ".2%"}
+	errorRate.Delta = &graph_objects.IndicatorDelta{
+		Reference:   graph_objects.Float64(0.0025),
+		ValueFormat: ".2%",
+		Position:    graph_objects.DeltaPositionBottom,
+		Increasing:  &graph_objects.DeltaDirection{Color: "#ef553b"},
+		Decreasing:  &graph_objects.DeltaDirection{Color: "#00cc96"},
+	}
+	errorRate.Domain = &graph_objects.Domain{X: []float64{0, 0.3}, Y: []float64{0, 0.45}}
+
+	// p99 latency on an angular gauge with the SLO as threshold
+	latency := graph_objects.NewIndicator()
+	latency.Mode = "gauge+number+delta"
+	latency.Value = 182
+	latency.Title = &graph_objects.IndicatorTitle{Text: "p99 latency (ms)"}
+	latency.Delta = &graph_objects.IndicatorDelta{
+		Reference:  graph_objects.Float64(200),
+		Increasing: &graph_objects.DeltaDirection{Color: "#ef553b"},
+		Decreasing: &graph_objects.DeltaDirection{Color: "#00cc96"},
+	}
+	latency.Gauge = &graph_objects.Gauge{
+		Axis: &graph_objects.GaugeAxis{Range: []float64{0, 400}},
+		Bar:  &graph_objects.GaugeBar{Color: "#636efa", Thickness: graph_objects.Float64(0.5)},
+		Steps: []graph_objects.GaugeStep{
+			{Range: []float64{0, 250}, Color: "#e5f5e0"},
+			{Range: []float64{250, 300}, Color: "#fff3cd"},
+			{Range: []float64{300, 400}, Color: "#fee0d2"},
+		},
+		Threshold: &graph_objects.GaugeThreshold{
+			Value:     300,
+			Line:      &graph_objects.GaugeLine{Color: "#ef553b", Width: 4},
+			Thickness: graph_objects.Float64(0.75),
+		},
+	}
+	latency.Domain = &graph_objects.Domain{X: []float64{0.35, 1}, Y: []float64{0.3, 1}}
+
+	// Disk usage on a bullet gauge
+	disk := graph_objects.NewIndicator()
+	disk.Mode = "number+gauge"
+	disk.Value = 71
+	disk.Title = &graph_objects.IndicatorTitle{Text: "Disk used"}
+	disk.Number = &graph_objects.IndicatorNumber{Suffix: "%"}
+	disk.Gauge = &graph_objects.Gauge{
+		Shape: graph_objects.GaugeShapeBullet,
+		Axis:  &graph_objects.GaugeAxis{Range: []float64{0, 100}},
+		Steps: []graph_objects.GaugeStep{
+			{Range: []float64{80, 100}, Color: "#fee0d2"},
+		},
+		Threshold: &graph_objects.GaugeThreshold{Value: 90, Line: &graph_objects.GaugeLine{Color: "#ef553b", Width: 2}},
+	}
+	disk.Domain = &graph_objects.Domain{X: []float64{0.5, 1}, Y: []float64{0, 0.15}}
+
+	// Add traces to figure
+	if err := fig.AddTraces(requests, errorRate, latency, disk); err != nil {
+		log.Fatal(err)
+	}
+
+	fig.Layout = &graph_objects.Layout{
+		Title:  &graph_objects.Title{Text: "Service Health"},
+		Width:  1000,
+		Height: 600,
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
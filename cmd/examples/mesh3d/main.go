@@ -0,0 +1,57 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Create a mesh3d trace of a square pyramid: four base corners and the apex
+	pyramid := graph_objects.NewMesh3d()
+	pyramid.Name = "Pyramid"
+	pyramid.X = []float64{0, 1, 1, 0, 0.5}
+	pyramid.Y = []float64{0, 0, 1, 1, 0.5}
+	pyramid.Z = []float64{0, 0, 0, 0, 1}
+
+	// Two triangles for the base and one for each side
+	pyramid.I = []int{0, 0, 0, 1, 2, 3}
+	pyramid.J = []int{1, 2, 1, 2, 3, 0}
+	pyramid.K = []int{2, 3, 4, 4, 4, 4}
+
+	// Color the vertices by height
+	pyramid.Intensity = pyramid.Z
+	pyramid.ColorScale = graph_objects.ColorScaleYlOrRd
+	pyramid.FlatShading = graph_objects.Bool(true)
+
+	// Add trace to figure
+	if err := fig.AddTraces(pyramid); err != nil {
+		log.Fatal(err)
+	}
+
+	// Set a typed layout with a scene
+	fig.Layout = &graph_objects.Layout{
+		Title:  &graph_objects.Title{Text: "Square Pyramid"},
+		Width:  900,
+		Height: 700,
+		Scene: &graph_objects.Scene{
+			AspectMode: string(graph_objects.AspectModeData),
+			Camera: &graph_objects.Camera{
+				Projection: &graph_objects.CameraProjection{Type: graph_objects.ProjectionOrthographic},
+			},
+		},
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Create pie trace for the cost breakdown
+	cost := graph_objects.NewPie()
+	cost.Name = "Cost"
+	cost.Labels = []string{"Compute", "Storage", "Network", "Support"}
+	cost.Values = []float64{4200, 1800, 950, 600}
+	cost.Pull = []float64{0.1, 0, 0, 0}
+	cost.TextInfo = "label+percent"
+	cost.Domain = &graph_objects.Domain{X: []float64{0, 0.48}}
+
+	// Create donut trace for the capacity usage
+	capacity := graph_objects.NewPie()
+	capacity.Name = "Capacity"
+	capacity.Labels = []string{"Used", "Reserved", "Free"}
+	capacity.Values = []float64{62, 18, 20}
+	capacity.Hole = 0.5
+	capacity.Sort = graph_objects.Bool(false)
+	capacity.Direction = graph_objects.PieDirectionClockwise
+	capacity.TextInfo = "percent"
+	capacity.InsideTextFont = &graph_objects.Font{Color: "white"}
+	capacity.Marker = &graph_objects.PieMarker{
+		Colors: []string{"#d62728", "#ff7f0e", "#2ca02c"},
+	}
+	capacity.Title = &graph_objects.PieTitle{Text: "Capacity"}
+	capacity.Domain = &graph_objects.Domain{X: []float64{0.52, 1}}
+
+	// Add traces to figure
+	if err := fig.AddTraces(cost, capacity); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Monthly Cost and Capacity",
+		},
+		"width":  900,
+		"height": 500,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Create a sankey trace of the requests flowing between services
+	sankey := graph_objects.NewSankey()
+	sankey.Node = &graph_objects.SankeyNode{
+		Label:     []string{"gateway", "auth", "catalog", "orders", "payments", "postgres", "redis"},
+		Color:     "#1f77b4",
+		Pad:       20,
+		Thickness: 20,
+	}
+	sankey.Link = &graph_objects.SankeyLink{
+		Source: []int{0, 0, 0, 1, 2, 2, 3, 3, 4},
+		Target: []int{1, 2, 3, 6, 5, 6, 4, 5, 5},
+		Value:  []float64{1200, 3400, 800, 1200, 1900, 1500, 450, 350, 450},
+		Color:  "rgba(31, 119, 180, 0.3)",
+	}
+	sankey.ValueSuffix = " req/s"
+
+	// Add trace to figure
+	if err := fig.AddTraces(sankey); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Requests Between Services",
+		},
+		"width":  900,
+		"height": 600,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
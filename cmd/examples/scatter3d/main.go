@@ -0,0 +1,71 @@
+package main
+
+import (
+	"log"
+	"math"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Sample points along a helix
+	var xs, ys, zs []float64
+	for i := 0; i <= 200; i++ {
+		t := float64(i) * math.Pi / 25
+		xs = append(xs, math.Cos(t))
+		ys = append(ys, math.Sin(t))
+		zs = append(zs, t/(2*math.Pi))
+	}
+
+	// Create scatter3d trace colored by height
+	helix := graph_objects.NewScatter3d()
+	helix.Name = "Helix"
+	helix.X = xs
+	helix.Y = ys
+	helix.Z = zs
+	helix.Mode = string(graph_objects.ModeLinesMarkers)
+	helix.Marker = &graph_objects.ScatterMarker{
+		Size:   3,
+		Color:  zs,
+		Symbol: graph_objects.Symbol3dCircle,
+	}
+	helix.Line = &graph_objects.ScatterLine{
+		Color: "rgb(120, 120, 120)",
+		Width: 2,
+	}
+
+	// Add trace to figure
+	if err := fig.AddTraces(helix); err != nil {
+		log.Fatal(err)
+	}
+
+	// Set a typed layout with a scene
+	fig.Layout = &graph_objects.Layout{
+		Title:  &graph_objects.Title{Text: "3D Helix"},
+		Width:  900,
+		Height: 700,
+		Scene: &graph_objects.Scene{
+			XAxis: &graph_objects.SceneAxis{Title: &graph_objects.Title{Text: "x"}},
+			YAxis: &graph_objects.SceneAxis{Title: &graph_objects.Title{Text: "y"}},
+			ZAxis: &graph_objects.SceneAxis{Title: &graph_objects.Title{Text: "Turns"}},
+			Camera: &graph_objects.Camera{
+				Eye: &graph_objects.Vector3{X: 1.6, Y: 1.6, Z: 0.8},
+			},
+			AspectMode:  string(graph_objects.AspectModeManual),
+			AspectRatio: &graph_objects.AspectRatio{X: 1, Y: 1, Z: 1.5},
+		},
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Data centers, sized by their share of the traffic
+	dataCenters := graph_objects.NewScattergeo()
+	dataCenters.Name = "Data centers"
+	dataCenters.Lon = []float64{-77.5, -122.3, -0.13, 8.68, 103.8, 139.7}
+	dataCenters.Lat = []float64{39.0, 47.6, 51.5, 50.1, 1.35, 35.7}
+	dataCenters.Text = []string{"Virginia", "Seattle", "London", "Frankfurt", "Singapore", "Tokyo"}
+	dataCenters.Mode = string(graph_objects.ModeMarkers)
+	dataCenters.Marker = &graph_objects.ScatterMarker{
+		Size:  []float64{28, 14, 20, 22, 16, 18},
+		Color: "rgb(239, 85, 59)",
+		Line:  &graph_objects.MarkerLine{Color: "white", Width: 1},
+	}
+	dataCenters.HoverTemplate = "%{text}<extra></extra>"
+
+	// Replication link between two data centers
+	link := graph_objects.NewScattergeo()
+	link.Name = "Replication"
+	link.Lon = []float64{-77.5, -0.13}
+	link.Lat = []float64{39.0, 51.5}
+	link.Mode = string(graph_objects.ModeLines)
+	link.Line = &graph_objects.ScatterLine{Color: "rgb(99, 110, 250)", Width: 2, Dash: graph_objects.DashDash}
+
+	// Countries with traffic, placed by ISO-3 code
+	countries := graph_objects.NewScattergeo()
+	countries.Name = "Countries"
+	countries.Locations = []string{"BRA", "IND", "AUS", "ZAF"}
+	countries.LocationMode = graph_objects.LocationModeISO3
+	countries.Mode = string(graph_objects.ModeMarkers)
+	countries.Marker = &graph_objects.ScatterMarker{Size: 10, Symbol: "diamond", Color: "rgb(0, 204, 150)"}
+
+	// Add traces to figure
+	if err := fig.AddTraces(dataCenters, link, countries); err != nil {
+		log.Fatal(err)
+	}
+
+	// Set a typed layout with a world map
+	fig.Layout = &graph_objects.Layout{
+		Title:  &graph_objects.Title{Text: "Data Centers"},
+		Width:  900,
+		Height: 550,
+		Geo: &graph_objects.Geo{
+			Projection:    &graph_objects.GeoProjection{Type: graph_objects.GeoProjectionNaturalEarth},
+			ShowLand:      graph_objects.Bool(true),
+			LandColor:     "#e5ecf6",
+			ShowCountries: graph_objects.Bool(true),
+			CountryColor:  "white",
+			ShowOcean:     graph_objects.Bool(true),
+			OceanColor:    "#f7fbff",
+		},
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
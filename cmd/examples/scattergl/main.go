@@ -0,0 +1,84 @@
+package main
+
+import (
+	"log"
+	"math"
+	"math/rand"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+// cluster returns n normally distributed points around (cx, cy)
+func cluster(rng *rand.Rand, n int, cx, cy, spread float64) ([]float64, []float64) {
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range x {
+		x[i] = cx + rng.NormFloat64()*spread
+		y[i] = cy + rng.NormFloat64()*spread
+	}
+	return x, y
+}
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Use a fixed seed so the plot is the same on every run
+	rng := rand.New(rand.NewSource(1))
+
+	// A WebGL trace with 100,000 points
+	background := graph_objects.NewScattergl()
+	background.Name = "Background"
+	background.X, background.Y = cluster(rng, 100000, 0, 0, 3)
+	background.Mode = string(graph_objects.ModeMarkers)
+	background.Marker = &graph_objects.ScatterMarker{Size: 2, Color: "rgba(99, 110, 250, 0.3)"}
+
+	// A regular scatter trace with 50,000 points on a ring, switched to WebGL
+	// by the figure's threshold below
+	ring := graph_objects.NewScatter()
+	ring.Name = "Ring"
+	ringX := make([]float64, 50000)
+	ringY := make([]float64, 50000)
+	for i := range ringX {
+		angle := rng.Float64() * 2 * math.Pi
+		radius := 8 + rng.NormFloat64()*0.3
+		ringX[i] = radius * math.Cos(angle)
+		ringY[i] = radius * math.Sin(angle)
+	}
+	ring.X = ringX
+	ring.Y = ringY
+	ring.Mode = string(graph_objects.ModeMarkers)
+	ring.Marker = &graph_objects.ScatterMarker{Size: 2, Color: "rgba(239, 85, 59, 0.4)"}
+
+	// A small scatter trace stays SVG
+	centers := graph_objects.NewScatter()
+	centers.Name = "Centers"
+	centers.X = []float64{0, 8, -8}
+	centers.Y = []float64{0, 0, 0}
+	centers.Mode = string(graph_objects.ModeMarkers)
+	centers.Marker = &graph_objects.ScatterMarker{Size: 12, Symbol: "x", Color: "black"}
+
+	// Add traces to figure
+	if err := fig.AddTraces(background, ring, centers); err != nil {
+		log.Fatal(err)
+	}
+
+	// Render scatter traces with more than 10,000 points with WebGL
+	fig.WebGLThreshold = 10000
+
+	fig.Layout = &graph_objects.Layout{
+		Title:  &graph_objects.Title{Text: "150,000 Points with WebGL"},
+		Width:  800,
+		Height: 800,
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Requests per hour, with the hours of the day around the circle
+	hours := []string{"00", "03", "06", "09", "12", "15", "18", "21", "00"}
+
+	// Create scatterpolar traces for two regions, closing each loop with the
+	// first value
+	eu := graph_objects.NewScatterpolar()
+	eu.Name = "EU"
+	eu.Theta = hours
+	eu.R = []float64{120, 80, 310, 920, 1100, 980, 760, 400, 120}
+	eu.Mode = string(graph_objects.ModeLinesMarkers)
+	eu.Fill = graph_objects.PolarFillToSelf
+	eu.Marker = &graph_objects.ScatterMarker{Size: 6}
+
+	us := graph_objects.NewScatterpolar()
+	us.Name = "US"
+	us.Theta = hours
+	us.R = []float64{640, 380, 150, 90, 240, 710, 980, 880, 640}
+	us.Mode = string(graph_objects.ModeLinesMarkers)
+	us.Fill = graph_objects.PolarFillToSelf
+	us.Line = &graph_objects.ScatterLine{Dash: graph_objects.DashDash}
+
+	// Add traces to figure
+	if err := fig.AddTraces(eu, us); err != nil {
+		log.Fatal(err)
+	}
+
+	// Set a typed layout with a polar subplot, midnight at the top
+	fig.Layout = &graph_objects.Layout{
+		Title:  &graph_objects.Title{Text: "Requests by Hour of Day"},
+		Width:  800,
+		Height: 700,
+		Polar: &graph_objects.Polar{
+			RadialAxis: &graph_objects.RadialAxis{
+				TickSuffix: " req/h",
+				Angle:      45,
+			},
+			AngularAxis: &graph_objects.AngularAxis{
+				Type:      string(graph_objects.AxisTypeCategory),
+				Direction: graph_objects.AngularDirectionClockwise,
+				Rotation:  90,
+			},
+		},
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
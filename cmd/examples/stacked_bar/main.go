@@ -56,30 +56,28 @@ func main() {
 		log.Fatalf("Failed to add traces: %v", err)
 	}
 
-	// Update layout
-	layout := map[string]interface{}{
-		"title": map[string]interface{}{
-			"text": "Quarterly Sales by Product (Stacked)",
-			"font": map[string]interface{}{
-				"size": 24,
-			},
+	// Set a typed layout
+	fig.Layout = &graph_objects.Layout{
+		Title: &graph_objects.Title{
+			Text: "Quarterly Sales by Product (Stacked)",
+			Font: &graph_objects.Font{Size: 24},
 		},
-		"xaxis": map[string]interface{}{
-			"title":     "Products",
-			"tickangle": -45,
+		XAxis: &graph_objects.Axis{
+			Title:     &graph_objects.Title{Text: "Products"},
+			TickAngle: -45,
 		},
-		"yaxis": map[string]interface{}{
-			"title": "Sales",
+		YAxis: &graph_objects.Axis{
+			Title: &graph_objects.Title{Text: "Sales"},
 		},
-		"barmode":       "stack",
-		"bargap":        0.15,
-		"showlegend":    true,
-		"plot_bgcolor":  "rgb(255, 255, 255)",
-		"paper_bgcolor": "rgb(255, 255, 255)",
+		BarMode:      string(graph_objects.BarModeStack),
+		BarGap:       graph_objects.Float64(0.15),
+		ShowLegend:   graph_objects.Bool(true),
+		PlotBgColor:  "rgb(255, 255, 255)",
+		PaperBgColor: "rgb(255, 255, 255)",
 	}
 
-	if err := fig.UpdateLayout(layout); err != nil {
-		log.Fatalf("Failed to update layout: %v", err)
+	if err := fig.Validate(); err != nil {
+		log.Fatalf("Invalid figure: %v", err)
 	}
 
 	// Show the plot
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Describe the cost allocation as a tree with totals on every node
+	costs := &graph_objects.HierarchyNode{
+		Label: "Cloud",
+		Value: 9400,
+		Children: []*graph_objects.HierarchyNode{
+			{Label: "Compute", Value: 5200, Children: []*graph_objects.HierarchyNode{
+				{Label: "api", Value: 2100},
+				{Label: "batch", Value: 1800},
+				{Label: "ml", Value: 1300},
+			}},
+			{Label: "Storage", Value: 2600, Children: []*graph_objects.HierarchyNode{
+				{Label: "objects", Value: 1500},
+				{Label: "databases", Value: 1100},
+			}},
+			{Label: "Network", Value: 1600},
+		},
+	}
+
+	// Create sunburst trace
+	sunburst := graph_objects.NewSunburst()
+	sunburst.SetHierarchy(graph_objects.HierarchyFromTree(costs))
+	sunburst.BranchValues = graph_objects.BranchValuesTotal
+	sunburst.TextInfo = "label+percent parent"
+	sunburst.InsideTextOrientation = graph_objects.InsideTextOrientationRadial
+
+	// Add trace to figure
+	if err := fig.AddTraces(sunburst); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Monthly Cost Allocation ($)",
+		},
+		"width":  700,
+		"height": 700,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"log"
+	"math"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Sample z = sin(r) / r on a grid
+	var xs, ys []float64
+	for i := 0; i <= 50; i++ {
+		xs = append(xs, -10+float64(i)*0.4)
+		ys = append(ys, -10+float64(i)*0.4)
+	}
+	z := make([][]float64, len(ys))
+	for i, y := range ys {
+		z[i] = make([]float64, len(xs))
+		for j, x := range xs {
+			r := math.Hypot(x, y)
+			if r == 0 {
+				z[i][j] = 1
+				continue
+			}
+			z[i][j] = math.Sin(r) / r
+		}
+	}
+
+	// Create surface trace with contours projected on the floor
+	surface := graph_objects.NewSurface()
+	surface.Z = z
+	surface.X = xs
+	surface.Y = ys
+	surface.ColorScale = graph_objects.ColorScaleViridis
+	surface.Contours = &graph_objects.SurfaceContours{
+		Z: &graph_objects.SurfaceContour{
+			Show:        graph_objects.Bool(true),
+			UseColorMap: graph_objects.Bool(true),
+			Project:     &graph_objects.SurfaceContourProject{Z: graph_objects.Bool(true)},
+		},
+	}
+	surface.Lighting = &graph_objects.Lighting{
+		Ambient:  graph_objects.Float64(0.6),
+		Specular: graph_objects.Float64(0.4),
+	}
+
+	// Add trace to figure
+	if err := fig.AddTraces(surface); err != nil {
+		log.Fatal(err)
+	}
+
+	// Set a typed layout with a scene
+	fig.Layout = &graph_objects.Layout{
+		Title:  &graph_objects.Title{Text: "sin(r) / r"},
+		Width:  900,
+		Height: 700,
+		Scene: &graph_objects.Scene{
+			ZAxis: &graph_objects.SceneAxis{
+				Range: []interface{}{-0.5, 1.5},
+			},
+			Camera: &graph_objects.Camera{
+				Eye: &graph_objects.Vector3{X: 1.4, Y: -1.4, Z: 0.9},
+			},
+		},
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
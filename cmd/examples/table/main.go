@@ -0,0 +1,87 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+// regionStats holds the numbers of one region, one table row each
+type regionStats struct {
+	Region   string
+	Requests int     `table:"Requests/day"`
+	Errors   float64 `table:"Error rate"`
+	Latency  float64 `table:"p99 latency"`
+}
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	stats := []regionStats{
+		{"EU West", 24500, 0.0021, 182},
+		{"EU North", 9700, 0.0008, 210},
+		{"EU Central", 31800, 0.0034, 165},
+		{"US East", 42100, 0.0017, 143},
+		{"APAC", 15600, 0.0052, 264},
+	}
+
+	// Create a bar chart of the requests in the left half of the figure
+	bar := graph_objects.NewBar()
+	bar.Name = "Requests/day"
+	bar.Marker = &graph_objects.BarMarker{Color: "#636efa"}
+	var regions []string
+	var requests []float64
+	for _, s := range stats {
+		regions = append(regions, s.Region)
+		requests = append(requests, float64(s.Requests))
+	}
+	bar.X = regions
+	bar.Y = requests
+
+	// Create a table of all numbers in the right half, showing the latency
+	// before the error rate
+	table, err := graph_objects.NewTableFromStructs(stats)
+	if err != nil {
+		log.Fatal(err)
+	}
+	table.ColumnOrder = []int{0, 1, 3, 2}
+	table.ColumnWidth = []float64{1.2, 1, 1, 1}
+	table.Domain = &graph_objects.Domain{X: []float64{0.5, 1}}
+	table.Header.Fill = &graph_objects.TableFill{Color: "#636efa"}
+	table.Header.Font = &graph_objects.Font{Color: "white", Size: 13}
+	table.Header.Align = graph_objects.TableAlignCenter
+	table.Cells.Format = []interface{}{"", ",d", ".2%", ""}
+	table.Cells.Suffix = []interface{}{"", "", "", " ms"}
+	table.Cells.Align = []string{
+		graph_objects.TableAlignLeft,
+		graph_objects.TableAlignRight,
+		graph_objects.TableAlignRight,
+		graph_objects.TableAlignRight,
+	}
+	table.Cells.Fill = &graph_objects.TableFill{Color: "#f5f7fb"}
+	table.Cells.Height = 28
+
+	// Add traces to figure
+	if err := fig.AddTraces(bar, table); err != nil {
+		log.Fatal(err)
+	}
+
+	fig.Layout = &graph_objects.Layout{
+		Title:      &graph_objects.Title{Text: "Requests by Region"},
+		Width:      1100,
+		Height:     450,
+		ShowLegend: graph_objects.Bool(false),
+		XAxis:      &graph_objects.Axis{Domain: []float64{0, 0.45}},
+	}
+
+	if err := fig.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
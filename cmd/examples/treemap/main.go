@@ -0,0 +1,61 @@
+package main
+
+import (
+	"log"
+	"strings"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Disk usage in GB by file path
+	paths := []string{
+		"home/alice/videos", "home/alice/documents", "home/bob/projects", "home/bob/downloads",
+		"var/lib/docker", "var/log", "usr/lib", "usr/share",
+	}
+	sizes := []float64{120, 14, 48, 31, 85, 9, 22, 11}
+
+	// Build the hierarchy from the path segments
+	segments := make([][]string, len(paths))
+	for i, path := range paths {
+		segments[i] = strings.Split(path, "/")
+	}
+	hierarchy, err := graph_objects.HierarchyFromPaths(segments, sizes)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create treemap trace
+	treemap := graph_objects.NewTreemap()
+	treemap.SetHierarchy(hierarchy)
+	treemap.TextInfo = "label+value"
+	treemap.Tiling = &graph_objects.TreemapTiling{Packing: graph_objects.PackingSquarify}
+	treemap.PathBar = &graph_objects.PathBar{Visible: graph_objects.Bool(true)}
+
+	// Add trace to figure
+	if err := fig.AddTraces(treemap); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Disk Usage (GB)",
+		},
+		"width":  900,
+		"height": 600,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"log"
+	"math/rand"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Generate latency samples before and after a release
+	r := rand.New(rand.NewSource(3))
+	services := []string{"api", "web", "worker"}
+	var before, after []float64
+	var beforeX, afterX []string
+	for i, service := range services {
+		for j := 0; j < 300; j++ {
+			before = append(before, 80+20*float64(i)+15*r.NormFloat64())
+			beforeX = append(beforeX, service)
+			after = append(after, 70+15*float64(i)+10*r.NormFloat64())
+			afterX = append(afterX, service)
+		}
+	}
+
+	// Create split violins sharing one scale
+	beforeTrace := graph_objects.NewViolin()
+	beforeTrace.Name = "before"
+	beforeTrace.X = beforeX
+	beforeTrace.Y = before
+	beforeTrace.Side = graph_objects.ViolinSideNegative
+	beforeTrace.ScaleGroup = "latency"
+	beforeTrace.LegendGroup = "before"
+	beforeTrace.Line = &graph_objects.ViolinLine{Color: "#1f77b4"}
+	beforeTrace.MeanLine = &graph_objects.ViolinMeanLine{Visible: graph_objects.Bool(true)}
+
+	afterTrace := graph_objects.NewViolin()
+	afterTrace.Name = "after"
+	afterTrace.X = afterX
+	afterTrace.Y = after
+	afterTrace.Side = graph_objects.ViolinSidePositive
+	afterTrace.ScaleGroup = "latency"
+	afterTrace.LegendGroup = "after"
+	afterTrace.Line = &graph_objects.ViolinLine{Color: "#ff7f0e"}
+	afterTrace.Box = &graph_objects.ViolinBox{
+		Visible: graph_objects.Bool(true),
+		Width:   0.3,
+	}
+	afterTrace.Points = graph_objects.BoxPointsOutliers
+
+	// Add traces to figure
+	if err := fig.AddTraces(beforeTrace, afterTrace); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Latency Before and After Release",
+		},
+		"yaxis": map[string]interface{}{
+			"title": "Latency (ms)",
+		},
+		"violinmode": "overlay",
+		"violingap":  0,
+		"width":      900,
+		"height":     600,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"log"
+
+	"github.com/ekinolik/go-plotly/pkg/figure"
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+func main() {
+	// Create a new figure
+	fig := figure.New()
+
+	// Create waterfall trace for the quarterly profit and loss
+	pnl := graph_objects.NewWaterfall()
+	pnl.Name = "Q3"
+	pnl.X = []string{"Revenue", "Services", "Cost of sales", "Operating", "Operating profit", "Taxes", "Net profit"}
+	pnl.Y = []float64{420, 80, -190, -120, 0, -45, 0}
+	pnl.Measure = []string{
+		graph_objects.MeasureRelative,
+		graph_objects.MeasureRelative,
+		graph_objects.MeasureRelative,
+		graph_objects.MeasureRelative,
+		graph_objects.MeasureTotal,
+		graph_objects.MeasureRelative,
+		graph_objects.MeasureTotal,
+	}
+	pnl.TextInfo = "delta"
+	pnl.TextPosition = graph_objects.TextPositionOutside
+	pnl.Connector = &graph_objects.Connector{
+		Line: &graph_objects.ConnectorLine{
+			Color: "rgb(63, 63, 63)",
+			Dash:  graph_objects.DashDot,
+		},
+	}
+	pnl.Increasing = &graph_objects.WaterfallDirection{
+		Marker: &graph_objects.BarMarker{Color: "#2ca02c"},
+	}
+	pnl.Decreasing = &graph_objects.WaterfallDirection{
+		Marker: &graph_objects.BarMarker{Color: "#d62728"},
+	}
+	pnl.Totals = &graph_objects.WaterfallDirection{
+		Marker: &graph_objects.BarMarker{Color: "#1f77b4"},
+	}
+
+	// Add trace to figure
+	if err := fig.AddTraces(pnl); err != nil {
+		log.Fatal(err)
+	}
+
+	// Update layout
+	layout := map[string]interface{}{
+		"title": map[string]interface{}{
+			"text": "Profit and Loss (k$)",
+		},
+		"showlegend": false,
+		"width":      900,
+		"height":     600,
+	}
+
+	if err := fig.UpdateLayout(layout); err != nil {
+		log.Fatal(err)
+	}
+
+	// Show the plot
+	if err := fig.Show(); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"runtime"
 )
 
-func openBrowser(url string) error {
+// openBrowser opens a URL in the default browser. It is a variable so that
+// tests can replace it.
+var openBrowser = func(url string) error {
 	var err error
 
 	switch runtime.GOOS {
@@ -3,12 +3,9 @@ package figure
 import (
 	"encoding/json"
 	"fmt"
-	"html/template"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
+	"reflect"
 
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
 	"github.com/ekinolik/go-plotly/pkg/validation"
 )
 
@@ -24,6 +21,11 @@ type Figure struct {
 	Layout interface{}   `json:"layout,omitempty"`
 	Config interface{}   `json:"config,omitempty"`
 
+	// WebGLThreshold switches *graph_objects.Scatter traces with more points
+	// than the threshold to scattergl when the figure is rendered, leaving
+	// Data unchanged. Zero disables the switch.
+	WebGLThreshold int `json:"-"`
+
 	// Internal state
 	framework string // Tracks which framework created the figure
 }
@@ -57,7 +59,8 @@ func (f *Figure) AddTraces(traces ...interface{}) error {
 	return nil
 }
 
-// UpdateLayout updates the figure's layout with the provided values
+// UpdateLayout updates the figure's layout with the provided values. The
+// layout may be a map[string]interface{} or a *graph_objects.Layout.
 func (f *Figure) UpdateLayout(updates map[string]interface{}) error {
 	if f.Layout == nil {
 		f.Layout = updates
@@ -65,12 +68,17 @@ func (f *Figure) UpdateLayout(updates map[string]interface{}) error {
 	}
 
 	// If layout already exists, merge the updates
-	if layout, ok := f.Layout.(map[string]interface{}); ok {
+	switch layout := f.Layout.(type) {
+	case map[string]interface{}:
 		for k, v := range updates {
 			layout[k] = v
 		}
-	} else {
-		return fmt.Errorf("existing layout is not a map")
+	case *graph_objects.Layout:
+		if err := layout.Update(updates); err != nil {
+			return fmt.Errorf("error updating layout: %v", err)
+		}
+	default:
+		return fmt.Errorf("existing layout is not a map or *graph_objects.Layout")
 	}
 	return nil
 }
@@ -119,10 +127,25 @@ func (f *Figure) Validate() error {
 		}
 	}
 
+	// Validate Layout
+	if validator, ok := f.Layout.(validation.Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-// UpdateTraces updates traces that match the selector
+// UpdateTraces updates traces that match the selector. Selector and update
+// keys are plotly attribute names and may be dotted paths such as
+// "marker.color". Typed traces keep their concrete type and are validated
+// after the update. Attributes without a typed field are stored in the
+// Extra properties of the trace.
+//
+// Matching traces are replaced in f.Data by an updated copy, so pointers to
+// the original typed traces do not see the update; read the updated traces
+// back from f.Data.
 func (f *Figure) UpdateTraces(update TraceUpdate) error {
 	for i, trace := range f.Data {
 		if matchesSelector(trace, update.Selector) {
@@ -136,146 +159,84 @@ func (f *Figure) UpdateTraces(update TraceUpdate) error {
 	return nil
 }
 
-// Show displays the figure in a web browser
-func (f *Figure) Show() error {
-	// Create a temporary directory if it doesn't exist
-	tmpDir := "temp_plots"
-	if err := os.MkdirAll(tmpDir, 0755); err != nil {
-		return fmt.Errorf("error creating temp directory: %v", err)
-	}
-
-	// Generate HTML content
-	html, err := f.ToHTML()
-	if err != nil {
-		return fmt.Errorf("error generating HTML: %v", err)
-	}
-
-	// Create HTML file with a more predictable name
-	fileName := fmt.Sprintf("%s/plot_%d.html", tmpDir, time.Now().UnixNano())
-	if err := os.WriteFile(fileName, []byte(html), 0644); err != nil {
-		return fmt.Errorf("error writing HTML file: %v", err)
-	}
-
-	// Convert to absolute path
-	absPath, err := filepath.Abs(fileName)
-	if err != nil {
-		return fmt.Errorf("error getting absolute path: %v", err)
-	}
-
-	// Open in browser
-	fileURL := fmt.Sprintf("file://%s", absPath)
-	if err := openBrowser(fileURL); err != nil {
-		return fmt.Errorf("error opening browser: %v", err)
-	}
-
-	fmt.Printf("Plot saved to: %s\n", fileName)
-	return nil
+// ToJSON converts the figure to JSON
+func (f *Figure) ToJSON() ([]byte, error) {
+	return json.Marshal(f)
 }
 
-// ToHTML converts the figure to HTML
-func (f *Figure) ToHTML() (string, error) {
-	const tmpl = `
-<!DOCTYPE html>
-<html>
-<head>
-    <script src="https://cdn.plot.ly/plotly-latest.min.js"></script>
-</head>
-<body>
-    <div id="plot"></div>
-    <script>
-        var data = {{.Data}};
-        var layout = {{.Layout}};
-        var config = {{.Config}};
-        Plotly.newPlot('plot', data, layout, config);
-    </script>
-</body>
-</html>
-`
-
-	// Convert figure data to JSON
-	data, err := json.Marshal(f.Data)
-	if err != nil {
-		return "", err
-	}
-
-	layout, err := json.Marshal(f.Layout)
-	if err != nil {
-		return "", err
-	}
-
-	config, err := json.Marshal(f.Config)
-	if err != nil {
-		return "", err
-	}
+// MarshalJSON implements the json.Marshaler interface, converting scatter
+// traces above the WebGL threshold to scattergl
+func (f *Figure) MarshalJSON() ([]byte, error) {
+	type figure Figure
+	aux := *(*figure)(f)
+	aux.Data = f.renderedData()
+	return json.Marshal(aux)
+}
 
-	// Create template data
-	templateData := struct {
-		Data   template.JS
-		Layout template.JS
-		Config template.JS
-	}{
-		Data:   template.JS(string(data)),
-		Layout: template.JS(string(layout)),
-		Config: template.JS(string(config)),
+// renderedData returns the traces as they are rendered, with scatter traces
+// that have more points than the WebGL threshold converted to scattergl
+func (f *Figure) renderedData() []interface{} {
+	if f.WebGLThreshold <= 0 {
+		return f.Data
 	}
-
-	// Execute template
-	t := template.Must(template.New("plot").Parse(tmpl))
-	var html strings.Builder
-	if err := t.Execute(&html, templateData); err != nil {
-		return "", err
+	data := make([]interface{}, len(f.Data))
+	for i, trace := range f.Data {
+		if scatter, ok := trace.(*graph_objects.Scatter); ok && scatterPoints(scatter) > f.WebGLThreshold {
+			trace = scatter.ToGL()
+		}
+		data[i] = trace
 	}
-
-	return html.String(), nil
+	return data
 }
 
-// Helper functions
-
-func matchesSelector(trace interface{}, selector map[string]interface{}) bool {
-	traceMap, ok := trace.(map[string]interface{})
-	if !ok {
-		return false
-	}
-
-	for k, v := range selector {
-		if traceValue, exists := traceMap[k]; !exists || traceValue != v {
-			return false
+// scatterPoints returns the number of points of a scatter trace, the length
+// of its longest coordinate array
+func scatterPoints(s *graph_objects.Scatter) int {
+	points := 0
+	for _, coords := range []interface{}{s.X, s.Y} {
+		if coords == nil {
+			continue
+		}
+		v := reflect.ValueOf(coords)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			continue
+		}
+		if v.Len() > points {
+			points = v.Len()
 		}
 	}
-	return true
+	return points
 }
 
-func applyUpdates(trace interface{}, updates map[string]interface{}) (interface{}, error) {
-	traceMap, ok := trace.(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("trace is not a map")
+// FromJSON creates a figure from JSON data. Traces are decoded into their
+// registered graph_objects types; traces of unknown type are decoded as
+// graph_objects.GenericTrace.
+func FromJSON(data []byte) (*Figure, error) {
+	var raw struct {
+		Data   []json.RawMessage `json:"data"`
+		Layout interface{}       `json:"layout"`
+		Config interface{}       `json:"config"`
 	}
-
-	// Create a copy of the trace
-	updatedTrace := make(map[string]interface{})
-	for k, v := range traceMap {
-		updatedTrace[k] = v
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
 	}
 
-	// Apply updates
-	for k, v := range updates {
-		updatedTrace[k] = v
+	fig := &Figure{
+		Data:      make([]interface{}, 0, len(raw.Data)),
+		Layout:    raw.Layout,
+		Config:    raw.Config,
+		framework: "go-plotly",
 	}
-
-	return updatedTrace, nil
-}
-
-// ToJSON converts the figure to JSON
-func (f *Figure) ToJSON() ([]byte, error) {
-	return json.Marshal(f)
-}
-
-// FromJSON creates a figure from JSON data
-func FromJSON(data []byte) (*Figure, error) {
-	var fig Figure
-	if err := json.Unmarshal(data, &fig); err != nil {
-		return nil, err
+	for i, traceData := range raw.Data {
+		if string(traceData) == "null" {
+			fig.Data = append(fig.Data, nil)
+			continue
+		}
+		trace, err := graph_objects.DecodeTrace(traceData)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding trace %d: %v", i, err)
+		}
+		fig.Data = append(fig.Data, trace)
 	}
-	fig.framework = "go-plotly"
-	return &fig, nil
+	return fig, nil
 }
@@ -2,8 +2,16 @@ package figure
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
+	"sync"
 	"testing"
+
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+	"github.com/ekinolik/go-plotly/pkg/plotlyjs"
 )
 
 func TestNewFigure(t *testing.T) {
@@ -51,6 +59,33 @@ func TestUpdateLayout(t *testing.T) {
 	}
 }
 
+func TestUpdateTypedLayout(t *testing.T) {
+	fig := New()
+	fig.Layout = &graph_objects.Layout{
+		Title:   &graph_objects.Title{Text: "Test Plot"},
+		BarMode: string(graph_objects.BarModeGroup),
+	}
+
+	err := fig.UpdateLayout(map[string]interface{}{
+		"barmode": "stack",
+		"width":   800,
+	})
+	if err != nil {
+		t.Errorf("UpdateLayout failed: %v", err)
+	}
+
+	layout := fig.Layout.(*graph_objects.Layout)
+	if layout.BarMode != string(graph_objects.BarModeStack) {
+		t.Errorf("Expected barmode 'stack', got '%s'", layout.BarMode)
+	}
+	if layout.Width != 800 {
+		t.Errorf("Expected width 800, got %v", layout.Width)
+	}
+	if layout.Title == nil || layout.Title.Text != "Test Plot" {
+		t.Error("Existing layout values were not preserved")
+	}
+}
+
 func TestJSON(t *testing.T) {
 	fig := New()
 	trace := map[string]interface{}{
@@ -80,6 +115,144 @@ func TestJSON(t *testing.T) {
 	}
 }
 
+func TestJSONTypedTraces(t *testing.T) {
+	fig := New()
+	scatter := graph_objects.NewScatter()
+	scatter.X = []float64{1, 2, 3}
+	scatter.Y = []float64{4, 5, 6}
+	scatter.Mode = string(graph_objects.ModeMarkers)
+
+	ohlc := graph_objects.NewOHLC()
+	ohlc.X = []string{"2024-01-01", "2024-01-02"}
+	ohlc.Open = []float64{33, 32}
+	ohlc.High = []float64{34, 33}
+	ohlc.Low = []float64{32, 31}
+	ohlc.Close = []float64{33.5, 31.5}
+
+	custom := map[string]interface{}{
+		"type": "custom",
+		"x":    []float64{1, 2, 3},
+	}
+	fig.AddTraces(scatter, ohlc, custom)
+
+	data, err := fig.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON failed: %v", err)
+	}
+
+	newFig, err := FromJSON(data)
+	if err != nil {
+		t.Fatalf("FromJSON failed: %v", err)
+	}
+
+	if _, ok := newFig.Data[0].(*graph_objects.Scatter); !ok {
+		t.Errorf("Expected *graph_objects.Scatter, got %T", newFig.Data[0])
+	}
+	if _, ok := newFig.Data[1].(*graph_objects.OHLC); !ok {
+		t.Errorf("Expected *graph_objects.OHLC, got %T", newFig.Data[1])
+	}
+	if _, ok := newFig.Data[2].(graph_objects.GenericTrace); !ok {
+		t.Errorf("Expected graph_objects.GenericTrace, got %T", newFig.Data[2])
+	}
+	if err := newFig.Validate(); err != nil {
+		t.Errorf("Validate failed after roundtrip: %v", err)
+	}
+
+	newData, err := newFig.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON failed: %v", err)
+	}
+	if string(data) != string(newData) {
+		t.Error("JSON roundtrip failed - figures don't match")
+	}
+}
+
+func TestJSONExtraAttributes(t *testing.T) {
+	data := []byte(`{"data":[{"type":"bar","x":[1],"y":[2],"xaxis":"x2","hovertemplate":"%{y}"}],"layout":{},"config":{}}`)
+	fig, err := FromJSON(data)
+	if err != nil {
+		t.Fatalf("FromJSON failed: %v", err)
+	}
+	if _, ok := fig.Data[0].(*graph_objects.Bar); !ok {
+		t.Fatalf("Expected *graph_objects.Bar, got %T", fig.Data[0])
+	}
+
+	newData, err := fig.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON failed: %v", err)
+	}
+	var want, got interface{}
+	json.Unmarshal(data, &want)
+	json.Unmarshal(newData, &got)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Attributes without a typed field were dropped: %s", newData)
+	}
+}
+
+func TestWebGLThreshold(t *testing.T) {
+	small := graph_objects.NewScatter()
+	small.X = []float64{1, 2}
+	small.Y = []float64{3, 4}
+
+	large := graph_objects.NewScatter()
+	large.Name = "large"
+	large.X = []float64{1, 2, 3, 4}
+	large.Y = []float64{5, 6, 7, 8}
+
+	tests := []struct {
+		name      string
+		threshold int
+		wantTypes []string
+	}{
+		{"disabled", 0, []string{"scatter", "scatter"}},
+		{"above threshold", 3, []string{"scatter", "scattergl"}},
+		{"at threshold", 4, []string{"scatter", "scatter"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fig := New()
+			fig.AddTraces(small, large)
+			fig.WebGLThreshold = tt.threshold
+
+			data, err := fig.ToJSON()
+			if err != nil {
+				t.Fatalf("ToJSON failed: %v", err)
+			}
+			var out struct {
+				Data []struct {
+					Type string `json:"type"`
+					Name string `json:"name"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(data, &out); err != nil {
+				t.Fatalf("Failed to unmarshal figure: %v", err)
+			}
+			for i, want := range tt.wantTypes {
+				if out.Data[i].Type != want {
+					t.Errorf("trace %d: expected type %q, got %q", i, want, out.Data[i].Type)
+				}
+			}
+			if out.Data[1].Name != "large" {
+				t.Errorf("Expected converted trace to keep its name, got %q", out.Data[1].Name)
+			}
+
+			html, err := fig.ToHTML()
+			if err != nil {
+				t.Fatalf("ToHTML failed: %v", err)
+			}
+			if got := strings.Contains(html, `"type":"scattergl"`); got != (tt.wantTypes[1] == "scattergl") {
+				t.Errorf("Expected scattergl in HTML: %v", !got)
+			}
+
+			// The figure's own traces are never modified
+			if fig.Data[1] != large || large.Type != "scatter" {
+				t.Error("Expected figure data to be unchanged")
+			}
+		})
+	}
+}
+
 func TestValidation(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -104,6 +277,14 @@ func TestValidation(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Invalid figure with invalid layout",
+			figure: &Figure{
+				Data:   []interface{}{},
+				Layout: &graph_objects.Layout{BarMode: "invalid"},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Invalid figure with nil trace",
 			figure: &Figure{
@@ -167,6 +348,215 @@ func TestUpdateTraces(t *testing.T) {
 	}
 }
 
+func TestUpdateTypedTraces(t *testing.T) {
+	fig := New()
+	scatter1 := graph_objects.NewScatter()
+	scatter1.Name = "trace1"
+	scatter1.X = []float64{1, 2, 3}
+	scatter1.Y = []float64{1, 2, 3}
+	scatter1.Marker = &graph_objects.ScatterMarker{Size: 10}
+
+	scatter2 := graph_objects.NewScatter()
+	scatter2.Name = "trace2"
+	scatter2.X = []float64{4, 5, 6}
+	scatter2.Y = []float64{4, 5, 6}
+
+	bar := graph_objects.NewBar()
+	bar.Name = "trace1"
+	bar.X = []string{"a", "b"}
+	bar.Y = []float64{1, 2}
+
+	fig.AddTraces(scatter1, scatter2, bar)
+
+	err := fig.UpdateTraces(TraceUpdate{
+		Selector: map[string]interface{}{
+			"type": "scatter",
+			"name": "trace1",
+		},
+		Updates: map[string]interface{}{
+			"mode":         "markers",
+			"marker.color": "red",
+			"line":         map[string]interface{}{"width": 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTraces failed: %v", err)
+	}
+
+	updated, ok := fig.Data[0].(*graph_objects.Scatter)
+	if !ok {
+		t.Fatalf("Expected *graph_objects.Scatter, got %T", fig.Data[0])
+	}
+	if updated.Mode != string(graph_objects.ModeMarkers) {
+		t.Errorf("Expected mode 'markers', got '%s'", updated.Mode)
+	}
+	if updated.Marker.Color != "red" || updated.Marker.Size != 10 {
+		t.Errorf("Nested update was not merged into marker: %+v", updated.Marker)
+	}
+	if updated.Line == nil || updated.Line.Width != 2 {
+		t.Error("Object update was not applied to line")
+	}
+
+	// The original trace is not modified
+	if scatter1.Mode != "" || scatter1.Marker.Color != nil {
+		t.Error("Update modified the original trace")
+	}
+
+	// Non-matching traces are not affected
+	if scatter2.Mode != "" || fig.Data[1] != scatter2 {
+		t.Error("Update was incorrectly applied to non-matching trace")
+	}
+	if fig.Data[2] != bar {
+		t.Error("Update was incorrectly applied to trace of another type")
+	}
+}
+
+func TestUpdateTypedTracesErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		updates map[string]interface{}
+	}{
+		{
+			name:    "unknown attribute",
+			updates: map[string]interface{}{"marker.nonexistent": 1},
+		},
+		{
+			name:    "invalid value",
+			updates: map[string]interface{}{"mode": "invalid"},
+		},
+		{
+			name:    "incompatible type",
+			updates: map[string]interface{}{"mode": []int{1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fig := New()
+			scatter := graph_objects.NewScatter()
+			scatter.X = []float64{1, 2, 3}
+			scatter.Y = []float64{1, 2, 3}
+			fig.AddTrace(scatter)
+
+			err := fig.UpdateTraces(TraceUpdate{Updates: tt.updates})
+			if err == nil {
+				t.Error("Expected error, got nil")
+			}
+			if fig.Data[0] != scatter {
+				t.Error("Failed update replaced the trace")
+			}
+		})
+	}
+}
+
+func TestUpdateTracesNestedMap(t *testing.T) {
+	fig := New()
+	fig.AddTrace(map[string]interface{}{
+		"type":   "scatter",
+		"marker": map[string]interface{}{"size": 10},
+	})
+
+	err := fig.UpdateTraces(TraceUpdate{
+		Selector: map[string]interface{}{"marker.size": 10},
+		Updates:  map[string]interface{}{"marker.color": "blue"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTraces failed: %v", err)
+	}
+
+	marker := fig.Data[0].(map[string]interface{})["marker"].(map[string]interface{})
+	if marker["color"] != "blue" || marker["size"] != 10 {
+		t.Errorf("Nested update was not applied correctly: %v", marker)
+	}
+}
+
+func TestUpdateTypedTracesExtra(t *testing.T) {
+	fig := New()
+	scatter := graph_objects.NewScatter()
+	scatter.X = []float64{1, 2, 3}
+	scatter.Y = []float64{1, 2, 3}
+	fig.AddTrace(scatter)
+
+	err := fig.UpdateTraces(TraceUpdate{
+		Updates: map[string]interface{}{"hovertemplate": "%{y}"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTraces failed: %v", err)
+	}
+
+	updated := fig.Data[0].(*graph_objects.Scatter)
+	if updated.Extra["hovertemplate"] != "%{y}" {
+		t.Errorf("Attribute without a field was not stored in Extra: %v", updated.Extra)
+	}
+	if scatter.Extra != nil {
+		t.Error("Update modified the original trace")
+	}
+
+	// Extra properties can be selected and are included in the JSON
+	err = fig.UpdateTraces(TraceUpdate{
+		Selector: map[string]interface{}{"hovertemplate": "%{y}"},
+		Updates:  map[string]interface{}{"name": "selected"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTraces failed: %v", err)
+	}
+	if fig.Data[0].(*graph_objects.Scatter).Name != "selected" {
+		t.Error("Selector did not match the Extra property")
+	}
+	data, err := fig.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON failed: %v", err)
+	}
+	if !strings.Contains(string(data), `"hovertemplate":"%{y}"`) {
+		t.Errorf("JSON output missing Extra property: %s", data)
+	}
+}
+
+// stringerTrace is a trace with an attribute of a non-empty interface type
+type stringerTrace struct {
+	Type  string       `json:"type"`
+	Label fmt.Stringer `json:"label,omitempty"`
+}
+
+func TestUpdateTracesInterfaceField(t *testing.T) {
+	fig := New()
+	trace := &stringerTrace{Type: "custom"}
+	fig.AddTrace(trace)
+
+	err := fig.UpdateTraces(TraceUpdate{
+		Updates: map[string]interface{}{"label.text": "a"},
+	})
+	if err == nil {
+		t.Error("Expected error for an attribute that cannot hold an object")
+	}
+	if fig.Data[0] != trace {
+		t.Error("Failed update replaced the trace")
+	}
+}
+
+func TestUpdateTracesStructInMap(t *testing.T) {
+	fig := New()
+	fig.AddTrace(map[string]interface{}{
+		"type":   "scatter",
+		"marker": graph_objects.ScatterMarker{Color: "red"},
+	})
+
+	err := fig.UpdateTraces(TraceUpdate{
+		Updates: map[string]interface{}{"marker.size": 12},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTraces failed: %v", err)
+	}
+
+	marker, ok := fig.Data[0].(map[string]interface{})["marker"].(graph_objects.ScatterMarker)
+	if !ok {
+		t.Fatalf("Expected graph_objects.ScatterMarker, got %T", fig.Data[0].(map[string]interface{})["marker"])
+	}
+	if marker.Size != 12 || marker.Color != "red" {
+		t.Errorf("Nested update was not applied to struct value: %+v", marker)
+	}
+}
+
 func TestToHTML(t *testing.T) {
 	fig := New()
 	trace := map[string]interface{}{
@@ -185,10 +575,268 @@ func TestToHTML(t *testing.T) {
 	if !strings.Contains(html, "<!DOCTYPE html>") {
 		t.Error("HTML output missing DOCTYPE")
 	}
-	if !strings.Contains(html, "plotly-latest.min.js") {
+	if !strings.Contains(html, plotlyjs.CDNURL()) {
 		t.Error("HTML output missing Plotly.js script")
 	}
 	if !strings.Contains(html, "Plotly.newPlot") {
 		t.Error("HTML output missing plot initialization")
 	}
 }
+
+func TestToHTMLWithOptions(t *testing.T) {
+	fig := New()
+	fig.AddTrace(map[string]interface{}{
+		"type": "scatter",
+		"x":    []float64{1, 2, 3},
+		"y":    []float64{1, 2, 3},
+	})
+
+	tests := []struct {
+		name        string
+		opts        HTMLOptions
+		contains    string
+		notContains string
+		wantErr     bool
+	}{
+		{
+			name:     "pinned CDN",
+			opts:     HTMLOptions{IncludePlotlyJS: PlotlyJSCDN},
+			contains: `<script src="` + plotlyjs.CDNURL() + `"></script>`,
+		},
+		{
+			name:     "local path",
+			opts:     HTMLOptions{IncludePlotlyJS: PlotlyJSPath, PlotlyJSPath: "js/plotly.min.js"},
+			contains: `<script src="js/plotly.min.js"></script>`,
+		},
+		{
+			name:    "local path without path",
+			opts:    HTMLOptions{IncludePlotlyJS: PlotlyJSPath},
+			wantErr: true,
+		},
+		{
+			name:        "omitted",
+			opts:        HTMLOptions{IncludePlotlyJS: PlotlyJSNone},
+			contains:    "Plotly.newPlot",
+			notContains: "<script src=",
+		},
+		{
+			name:    "invalid mode",
+			opts:    HTMLOptions{IncludePlotlyJS: "invalid"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			html, err := fig.ToHTMLWithOptions(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToHTMLWithOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.contains != "" && !strings.Contains(html, tt.contains) {
+				t.Errorf("HTML output missing %q", tt.contains)
+			}
+			if tt.notContains != "" && strings.Contains(html, tt.notContains) {
+				t.Errorf("HTML output unexpectedly contains %q", tt.notContains)
+			}
+		})
+	}
+}
+
+// fakePlotlyJS points plotlyjs.EnvVar at a stand-in bundle so that inline
+// output can be tested without the vendored plotly.js
+func fakePlotlyJS(t *testing.T) string {
+	t.Helper()
+	const bundle = "/* plotly.js test bundle */"
+	if plotlyjs.Available() && os.Getenv(plotlyjs.EnvVar) == "" {
+		data, err := plotlyjs.Bundle()
+		if err != nil {
+			t.Fatalf("Bundle failed: %v", err)
+		}
+		return string(data)
+	}
+	path := filepath.Join(t.TempDir(), "plotly.min.js")
+	if err := os.WriteFile(path, []byte(bundle), 0644); err != nil {
+		t.Fatalf("Failed to write test bundle: %v", err)
+	}
+	t.Setenv(plotlyjs.EnvVar, path)
+	return bundle
+}
+
+func TestToHTMLInline(t *testing.T) {
+	bundle := fakePlotlyJS(t)
+
+	fig := New()
+	html, err := fig.ToHTMLWithOptions(HTMLOptions{IncludePlotlyJS: PlotlyJSInline})
+	if err != nil {
+		t.Fatalf("ToHTMLWithOptions failed: %v", err)
+	}
+	if strings.Contains(html, "<script src=") {
+		t.Error("Inline HTML output references an external script")
+	}
+	if !strings.Contains(html, `<script type="text/javascript">`+bundle+`</script>`) {
+		t.Error("Inline HTML output missing plotly.js bundle")
+	}
+}
+
+func TestToHTMLNotVendored(t *testing.T) {
+	if plotlyjs.Available() && os.Getenv(plotlyjs.EnvVar) == "" {
+		t.Skip("plotly.js bundle is vendored")
+	}
+	t.Setenv(plotlyjs.EnvVar, "")
+
+	var warning strings.Builder
+	originalWarning := cdnWarning
+	cdnWarning = &warning
+	cdnWarningOnce = sync.Once{}
+	t.Cleanup(func() { cdnWarning = originalWarning })
+
+	fig := New()
+	if _, err := fig.ToHTMLWithOptions(HTMLOptions{IncludePlotlyJS: PlotlyJSInline}); err != plotlyjs.ErrNotVendored {
+		t.Errorf("Expected ErrNotVendored, got %v", err)
+	}
+
+	// Offline output falls back to the pinned CDN version with a warning
+	path, cleanup, err := fig.WriteTempHTML()
+	if err != nil {
+		t.Fatalf("WriteTempHTML failed: %v", err)
+	}
+	defer cleanup()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", path, err)
+	}
+	if !strings.Contains(string(content), plotlyjs.CDNURL()) {
+		t.Error("CDN fallback output missing the plotly.js CDN URL")
+	}
+	if _, err := fig.MIMEBundle(); err != nil {
+		t.Errorf("MIMEBundle failed: %v", err)
+	}
+	if strings.Count(warning.String(), plotlyjs.CDNURL()) != 1 {
+		t.Errorf("Expected a single CDN fallback warning, got %q", warning.String())
+	}
+
+	// No warning when the CDN is explicitly allowed
+	warning.Reset()
+	cdnWarningOnce = sync.Once{}
+	t.Setenv(plotlyjs.EnvVar, "cdn")
+	if mode := offlinePlotlyJSMode(); mode != PlotlyJSCDN {
+		t.Errorf("Expected PlotlyJSCDN, got %s", mode)
+	}
+	if warning.Len() != 0 {
+		t.Errorf("Unexpected warning: %q", warning.String())
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	fig := New()
+	fig.AddTrace(map[string]interface{}{
+		"type": "bar",
+		"y":    []float64{1, 2, 3},
+	})
+
+	var buf strings.Builder
+	err := fig.WriteHTML(&buf, HTMLOptions{
+		DivOnly:    true,
+		DivID:      "sales-chart",
+		Width:      "100%",
+		Height:     "400px",
+		Responsive: true,
+	})
+	if err != nil {
+		t.Fatalf("WriteHTML failed: %v", err)
+	}
+
+	html := buf.String()
+	if strings.Contains(html, "<!DOCTYPE html>") {
+		t.Error("Fragment output contains DOCTYPE")
+	}
+	if !strings.Contains(html, `<div id="sales-chart" style="width:100%;height:400px;"></div>`) {
+		t.Error("Fragment output missing sized plot div")
+	}
+	if !strings.Contains(html, `Plotly.newPlot("sales-chart"`) {
+		t.Error("Fragment output missing plot initialization")
+	}
+	if !strings.Contains(html, `"responsive":true`) {
+		t.Error("Fragment output missing responsive config")
+	}
+}
+
+func TestWriteHTMLDocument(t *testing.T) {
+	fig := New()
+
+	var buf strings.Builder
+	err := fig.WriteHTML(&buf, HTMLOptions{
+		Title:     "Latency <p99>",
+		ExtraHead: `<link rel="stylesheet" href="report.css">`,
+	})
+	if err != nil {
+		t.Fatalf("WriteHTML failed: %v", err)
+	}
+
+	html := buf.String()
+	if !strings.Contains(html, "<title>Latency &lt;p99&gt;</title>") {
+		t.Error("Document output missing escaped title")
+	}
+	if !strings.Contains(html, `<link rel="stylesheet" href="report.css">`) {
+		t.Error("Document output missing extra head content")
+	}
+}
+
+func TestHTMLUniqueDivIDs(t *testing.T) {
+	fig := New()
+	first, err := fig.ToHTMLWithOptions(HTMLOptions{DivOnly: true})
+	if err != nil {
+		t.Fatalf("ToHTMLWithOptions failed: %v", err)
+	}
+	second, err := fig.ToHTMLWithOptions(HTMLOptions{DivOnly: true})
+	if err != nil {
+		t.Fatalf("ToHTMLWithOptions failed: %v", err)
+	}
+	if first == second {
+		t.Error("Fragments of separate renders share a div id")
+	}
+}
+
+func TestWriteHTMLPage(t *testing.T) {
+	fig1 := New()
+	fig1.AddTrace(map[string]interface{}{"type": "bar", "y": []float64{1, 2}})
+	fig2 := New()
+	fig2.AddTrace(map[string]interface{}{"type": "scatter", "x": []float64{1}, "y": []float64{2}})
+
+	var buf strings.Builder
+	err := WriteHTMLPage(&buf, HTMLOptions{Title: "Report", DivID: "fig"}, fig1, fig2)
+	if err != nil {
+		t.Fatalf("WriteHTMLPage failed: %v", err)
+	}
+
+	html := buf.String()
+	if strings.Count(html, "<script src=") != 1 {
+		t.Error("Page output should include plotly.js exactly once")
+	}
+	for _, id := range []string{"fig-0", "fig-1"} {
+		if !strings.Contains(html, `<div id="`+id+`"></div>`) {
+			t.Errorf("Page output missing div %s", id)
+		}
+	}
+	if strings.Count(html, "Plotly.newPlot") != 2 {
+		t.Error("Page output should initialize both figures")
+	}
+
+	if err := WriteHTMLPage(&buf, HTMLOptions{}, fig1, nil); err == nil {
+		t.Error("Expected error for nil figure")
+	}
+
+	bundle := fakePlotlyJS(t)
+	buf.Reset()
+	err = WriteHTMLPage(&buf, HTMLOptions{IncludePlotlyJS: PlotlyJSInline}, fig1, fig2)
+	if err != nil {
+		t.Fatalf("WriteHTMLPage inline failed: %v", err)
+	}
+	html = buf.String()
+	if strings.Contains(html, "<script src=") {
+		t.Error("Inline page output references an external script")
+	}
+	if strings.Count(html, bundle) != 1 {
+		t.Error("Inline page output should include the plotly.js bundle exactly once")
+	}
+}
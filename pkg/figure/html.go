@@ -0,0 +1,321 @@
+package figure
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/ekinolik/go-plotly/pkg/plotlyjs"
+)
+
+// PlotlyJSMode controls how plotly.js is included in HTML output
+type PlotlyJSMode string
+
+const (
+	// PlotlyJSCDN references the pinned plotly.js version on the plotly CDN
+	PlotlyJSCDN PlotlyJSMode = "cdn"
+	// PlotlyJSInline inlines the vendored plotly.js bundle, for pages that
+	// must render without network access
+	PlotlyJSInline PlotlyJSMode = "inline"
+	// PlotlyJSPath references plotly.js at HTMLOptions.PlotlyJSPath
+	PlotlyJSPath PlotlyJSMode = "path"
+	// PlotlyJSNone omits the plotly.js script, for pages that load it themselves
+	PlotlyJSNone PlotlyJSMode = "none"
+)
+
+// HTMLOptions configures HTML output
+type HTMLOptions struct {
+	// IncludePlotlyJS selects how plotly.js is included. Defaults to PlotlyJSCDN.
+	IncludePlotlyJS PlotlyJSMode
+	// PlotlyJSPath is the script src used with PlotlyJSPath, e.g. "plotly.min.js"
+	PlotlyJSPath string
+
+	// DivOnly renders only the plot div and its scripts instead of a full
+	// document, for embedding into other pages
+	DivOnly bool
+	// DivID is the id of the plot div. Defaults to a unique generated id.
+	DivID string
+	// Width and Height are CSS sizes of the plot div, e.g. "100%" or "600px"
+	Width  string
+	Height string
+	// Responsive resizes the plot with its container
+	Responsive bool
+
+	// Title is the page title of a full document
+	Title string
+	// ExtraHead is added to the <head> of a full document
+	ExtraHead template.HTML
+}
+
+const htmlTemplates = `
+{{- define "plotlyjs"}}
+    {{- if .PlotlyJSSrc}}
+    <script src="{{.PlotlyJSSrc}}"></script>
+    {{- else if .PlotlyJSInline}}
+    <script type="text/javascript">{{.PlotlyJSInline}}</script>
+    {{- end}}
+{{- end}}
+
+{{- define "plot"}}
+    <div id="{{.DivID}}"
+        {{- if or .Width .Height}} style="
+        {{- if .Width}}width:{{.Width}};{{end}}
+        {{- if .Height}}height:{{.Height}};{{end}}"
+        {{- end}}></div>
+    <script>
+        Plotly.newPlot({{.DivID}}, {{.Data}}, {{.Layout}}, {{.Config}});
+    </script>
+{{- end}}
+
+{{- define "head"}}
+<head>
+    <meta charset="utf-8">
+    {{- if .Title}}
+    <title>{{.Title}}</title>
+    {{- end}}
+    {{- template "plotlyjs" .}}
+    {{- if .ExtraHead}}
+    {{.ExtraHead}}
+    {{- end}}
+</head>
+{{- end}}
+
+{{- define "document"}}
+<!DOCTYPE html>
+<html>
+{{- template "head" .}}
+<body>
+    {{- template "plot" .}}
+</body>
+</html>
+{{end}}
+
+{{- define "fragment"}}
+<div>
+    {{- template "plotlyjs" .}}
+    {{- template "plot" .}}
+</div>
+{{end}}
+
+{{- define "page"}}
+<!DOCTYPE html>
+<html>
+{{- template "head" .}}
+<body>
+    {{- range .Plots}}
+    {{- template "plot" .}}
+    {{- end}}
+</body>
+</html>
+{{end}}
+`
+
+var plotTemplates = template.Must(template.New("plot").Parse(htmlTemplates))
+
+// htmlData holds the values shared by the HTML templates
+type htmlData struct {
+	PlotlyJSSrc    string
+	PlotlyJSInline template.JS
+	Title          string
+	ExtraHead      template.HTML
+	plotData
+	Plots []plotData
+}
+
+// plotData holds the values of a single plot div
+type plotData struct {
+	DivID  string
+	Width  string
+	Height string
+	Data   template.JS
+	Layout template.JS
+	Config template.JS
+}
+
+// ToHTML converts the figure to HTML, loading the pinned plotly.js version
+// from the CDN
+func (f *Figure) ToHTML() (string, error) {
+	return f.ToHTMLWithOptions(HTMLOptions{})
+}
+
+// ToHTMLWithOptions converts the figure to HTML using the given options
+func (f *Figure) ToHTMLWithOptions(opts HTMLOptions) (string, error) {
+	var html strings.Builder
+	if err := f.WriteHTML(&html, opts); err != nil {
+		return "", err
+	}
+	return html.String(), nil
+}
+
+// WriteOfflineHTML writes the figure as a self-contained HTML document to w.
+// plotly.js is inlined when it is available, and loaded from the pinned CDN
+// version with a warning otherwise.
+func (f *Figure) WriteOfflineHTML(w io.Writer) error {
+	return f.WriteHTML(w, HTMLOptions{IncludePlotlyJS: offlinePlotlyJSMode()})
+}
+
+// WriteHTML writes the figure as HTML to w using the given options
+func (f *Figure) WriteHTML(w io.Writer, opts HTMLOptions) error {
+	data, err := newHTMLData(opts)
+	if err != nil {
+		return err
+	}
+
+	data.plotData, err = f.plotData(opts, opts.DivID)
+	if err != nil {
+		return err
+	}
+
+	name := "document"
+	if opts.DivOnly {
+		name = "fragment"
+	}
+	return plotTemplates.ExecuteTemplate(w, name, data)
+}
+
+// WriteHTMLPage writes a single HTML document holding all figures to w.
+// plotly.js is included once; use PlotlyJSInline for a self-contained page.
+// DivOnly is ignored and DivID, when set, is used as a prefix for the ids of
+// the plot divs.
+func WriteHTMLPage(w io.Writer, opts HTMLOptions, figs ...*Figure) error {
+	data, err := newHTMLData(opts)
+	if err != nil {
+		return err
+	}
+
+	for i, fig := range figs {
+		if fig == nil {
+			return fmt.Errorf("figure %d is nil", i)
+		}
+		divID := ""
+		if opts.DivID != "" {
+			divID = fmt.Sprintf("%s-%d", opts.DivID, i)
+		}
+		plot, err := fig.plotData(opts, divID)
+		if err != nil {
+			return fmt.Errorf("error rendering figure %d: %v", i, err)
+		}
+		data.Plots = append(data.Plots, plot)
+	}
+
+	return plotTemplates.ExecuteTemplate(w, "page", data)
+}
+
+// cdnWarning is where offlinePlotlyJSMode warns once that plotly.js is
+// loaded from the CDN
+var (
+	cdnWarning     io.Writer = os.Stderr
+	cdnWarningOnce sync.Once
+)
+
+// offlinePlotlyJSMode returns PlotlyJSInline when a plotly.js bundle is
+// available. Otherwise it falls back to the pinned CDN version, with a
+// warning unless the CDN is explicitly allowed through plotlyjs.EnvVar.
+func offlinePlotlyJSMode() PlotlyJSMode {
+	if plotlyjs.Available() {
+		return PlotlyJSInline
+	}
+	if !plotlyjs.CDNAllowed() {
+		cdnWarningOnce.Do(func() {
+			fmt.Fprintf(cdnWarning, "go-plotly: %v; loading plotly.js from %s\n", plotlyjs.ErrNotVendored, plotlyjs.CDNURL())
+		})
+	}
+	return PlotlyJSCDN
+}
+
+// newHTMLData returns the page-level template values for the options
+func newHTMLData(opts HTMLOptions) (htmlData, error) {
+	data := htmlData{
+		Title:     opts.Title,
+		ExtraHead: opts.ExtraHead,
+	}
+
+	switch opts.IncludePlotlyJS {
+	case "", PlotlyJSCDN:
+		data.PlotlyJSSrc = plotlyjs.CDNURL()
+	case PlotlyJSInline:
+		bundle, err := plotlyjs.Bundle()
+		if err != nil {
+			return data, err
+		}
+		data.PlotlyJSInline = template.JS(string(bundle))
+	case PlotlyJSPath:
+		if opts.PlotlyJSPath == "" {
+			return data, fmt.Errorf("plotly.js path mode requires PlotlyJSPath")
+		}
+		data.PlotlyJSSrc = opts.PlotlyJSPath
+	case PlotlyJSNone:
+	default:
+		return data, fmt.Errorf("invalid plotly.js mode: %s", opts.IncludePlotlyJS)
+	}
+
+	return data, nil
+}
+
+// plotData returns the template values of the figure's plot div
+func (f *Figure) plotData(opts HTMLOptions, divID string) (plotData, error) {
+	// Convert figure data to JSON
+	data, err := json.Marshal(f.renderedData())
+	if err != nil {
+		return plotData{}, err
+	}
+
+	layout, err := json.Marshal(f.Layout)
+	if err != nil {
+		return plotData{}, err
+	}
+
+	config, err := f.configJSON(opts.Responsive)
+	if err != nil {
+		return plotData{}, err
+	}
+
+	if divID == "" {
+		divID, err = newDivID()
+		if err != nil {
+			return plotData{}, err
+		}
+	}
+
+	return plotData{
+		DivID:  divID,
+		Width:  opts.Width,
+		Height: opts.Height,
+		Data:   template.JS(string(data)),
+		Layout: template.JS(string(layout)),
+		Config: template.JS(string(config)),
+	}, nil
+}
+
+// configJSON returns the figure config as JSON, enabling plotly's responsive
+// option when requested
+func (f *Figure) configJSON(responsive bool) ([]byte, error) {
+	config, err := json.Marshal(f.Config)
+	if err != nil || !responsive {
+		return config, err
+	}
+
+	m := make(map[string]interface{})
+	if f.Config != nil {
+		if err := json.Unmarshal(config, &m); err != nil {
+			return nil, fmt.Errorf("config is not an object: %v", err)
+		}
+	}
+	m["responsive"] = true
+	return json.Marshal(m)
+}
+
+// newDivID returns a unique id for a plot div
+func newDivID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "plot-" + hex.EncodeToString(b), nil
+}
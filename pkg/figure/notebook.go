@@ -0,0 +1,62 @@
+package figure
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MIME types of the notebook MIME bundle
+const (
+	MIMETypePlotly = "application/vnd.plotly.v1+json"
+	MIMETypeHTML   = "text/html"
+	MIMETypeSVG    = "image/svg+xml"
+	MIMETypeText   = "text/plain"
+)
+
+// MIMEBundle returns the figure as a Jupyter MIME bundle. The figure JSON is
+// rendered by the plotly extension of JupyterLab and VS Code, and frontends
+// without it fall back to an HTML fragment or an SVG image. The SVG fallback
+// is omitted for traces that static export does not support, and the HTML
+// fallback when it cannot be generated.
+func (f *Figure) MIMEBundle() (map[string]interface{}, error) {
+	data, err := f.ToJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	// Inline plotly.js when it is available so that saved notebooks work offline
+	opts := HTMLOptions{
+		IncludePlotlyJS: offlinePlotlyJSMode(),
+		DivOnly:         true,
+		Responsive:      true,
+	}
+	html, err := f.ToHTMLWithOptions(opts)
+	if err != nil && opts.IncludePlotlyJS == PlotlyJSInline {
+		// The other parts do not need plotly.js, so a bundle that cannot be
+		// read only switches the HTML fragment to the CDN
+		opts.IncludePlotlyJS = PlotlyJSCDN
+		html, err = f.ToHTMLWithOptions(opts)
+	}
+
+	bundle := map[string]interface{}{
+		MIMETypePlotly: json.RawMessage(data),
+		MIMETypeText:   fmt.Sprintf("Figure(traces=%d)", len(f.Data)),
+	}
+	if err == nil {
+		bundle[MIMETypeHTML] = html
+	}
+	if svg, err := f.ToSVG(); err == nil {
+		bundle[MIMETypeSVG] = svg
+	}
+	return bundle, nil
+}
+
+// SimpleRender displays the figure inline in gophernotes when it is the
+// value of a cell. Errors are displayed as text.
+func (f *Figure) SimpleRender() map[string]interface{} {
+	bundle, err := f.MIMEBundle()
+	if err != nil {
+		return map[string]interface{}{MIMETypeText: err.Error()}
+	}
+	return bundle
+}
@@ -0,0 +1,111 @@
+package figure
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ekinolik/go-plotly/pkg/plotlyjs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMIMEBundle(t *testing.T) {
+	plotlyJS := fakePlotlyJS(t)
+
+	tests := []struct {
+		name    string
+		trace   interface{}
+		wantSVG bool
+	}{
+		{
+			name:    "static export supported",
+			trace:   map[string]interface{}{"type": "scatter", "x": []int{1, 2, 3}, "y": []int{4, 5, 6}},
+			wantSVG: true,
+		},
+		{
+			name:    "static export unsupported",
+			trace:   map[string]interface{}{"type": "pie", "values": []int{1, 2}},
+			wantSVG: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fig := New()
+			fig.AddTrace(tt.trace)
+
+			bundle, err := fig.MIMEBundle()
+			assert.NoError(t, err)
+
+			// The plotly JSON is the ToJSON output
+			want, err := fig.ToJSON()
+			assert.NoError(t, err)
+			data, err := json.Marshal(bundle[MIMETypePlotly])
+			assert.NoError(t, err)
+			assert.JSONEq(t, string(want), string(data))
+
+			html, ok := bundle[MIMETypeHTML].(string)
+			assert.True(t, ok)
+			assert.Contains(t, html, "Plotly.newPlot(")
+			assert.Contains(t, html, plotlyJS, "plotly.js should be inlined")
+			assert.NotContains(t, html, "<html>", "HTML fallback should be a fragment")
+
+			svg, ok := bundle[MIMETypeSVG].(string)
+			assert.Equal(t, tt.wantSVG, ok)
+			if tt.wantSVG {
+				assert.True(t, strings.HasPrefix(svg, "<svg"))
+			}
+			assert.Equal(t, "Figure(traces=1)", bundle[MIMETypeText])
+		})
+	}
+}
+
+func TestSimpleRender(t *testing.T) {
+	fakePlotlyJS(t)
+	bundle := showFigure().SimpleRender()
+	assert.Contains(t, bundle, MIMETypePlotly)
+	assert.Contains(t, bundle, MIMETypeHTML)
+	assert.Contains(t, bundle, MIMETypeSVG)
+
+	fig := New()
+	fig.Layout = func() {}
+	bundle = fig.SimpleRender()
+	assert.Len(t, bundle, 1)
+	assert.Contains(t, bundle[MIMETypeText], "unsupported type")
+}
+
+func TestMIMEBundleUnreadablePlotlyJS(t *testing.T) {
+	// A plotly.js bundle that cannot be read only affects the HTML fragment
+	t.Setenv(plotlyjs.EnvVar, filepath.Join(t.TempDir(), "missing.js"))
+
+	bundle, err := showFigure().MIMEBundle()
+	assert.NoError(t, err)
+	assert.Contains(t, bundle, MIMETypePlotly)
+	assert.Contains(t, bundle, MIMETypeSVG)
+	assert.Contains(t, bundle[MIMETypeHTML], plotlyjs.CDNURL())
+}
+
+// gophernoteRenderer is the interface gophernotes uses to display the value
+// of a cell with a MIME bundle
+type gophernoteRenderer interface {
+	SimpleRender() map[string]interface{}
+}
+
+func TestNotebookDisplay(t *testing.T) {
+	fakePlotlyJS(t)
+
+	var value interface{} = showFigure()
+	renderer, ok := value.(gophernoteRenderer)
+	if !assert.True(t, ok, "*Figure should be displayable as a gophernotes cell value") {
+		return
+	}
+
+	bundle := renderer.SimpleRender()
+	want, err := showFigure().ToJSON()
+	assert.NoError(t, err)
+	data, err := json.Marshal(bundle[MIMETypePlotly])
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(want), string(data))
+	assert.Contains(t, bundle[MIMETypeHTML], "Plotly.newPlot(")
+}
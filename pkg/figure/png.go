@@ -0,0 +1,390 @@
+package figure
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"sort"
+)
+
+// Raster images are drawn at twice their size and downsampled, which
+// smooths the edges of shapes and text
+const supersampling = 2
+
+// maxPNGPixels limits the size of raster images
+const maxPNGPixels = 100_000_000
+
+// WritePNG renders the figure as a static PNG image. Width and height default
+// to the layout size when zero, and scale multiplies the pixel density (0
+// means 1). Like WriteSVG, only scatter, bar, histogram, box and ohlc traces
+// are supported.
+func (f *Figure) WritePNG(w io.Writer, width, height int, scale float64) error {
+	if width < 0 || height < 0 {
+		return fmt.Errorf("invalid image size %dx%d", width, height)
+	}
+	if scale < 0 || math.IsNaN(scale) || math.IsInf(scale, 0) {
+		return fmt.Errorf("invalid scale: %v", scale)
+	}
+	if scale == 0 {
+		scale = 1
+	}
+
+	ch, err := f.newStaticChart(float64(width), float64(height))
+	if err != nil {
+		return err
+	}
+
+	pixels := ch.width * ch.height * scale * scale
+	if pixels > maxPNGPixels {
+		return fmt.Errorf("image of %.0fx%.0f at scale %v is too large", ch.width, ch.height, scale)
+	}
+
+	c := newPNGCanvas(ch.width, ch.height, scale)
+	ch.draw(c)
+	return png.Encode(w, c.image())
+}
+
+// pngCanvas draws onto a raster image
+type pngCanvas struct {
+	img   *image.RGBA
+	scale float64 // pixels per canvas unit, including supersampling
+	clip  image.Rectangle
+	out   image.Rectangle // size of the final image
+}
+
+func newPNGCanvas(width, height, scale float64) *pngCanvas {
+	out := image.Rect(0, 0, int(math.Round(width*scale)), int(math.Round(height*scale)))
+	bounds := image.Rect(0, 0, out.Dx()*supersampling, out.Dy()*supersampling)
+	return &pngCanvas{
+		img:   image.NewRGBA(bounds),
+		scale: scale * supersampling,
+		clip:  bounds,
+		out:   out,
+	}
+}
+
+// image downsamples the canvas to the final image
+func (c *pngCanvas) image() *image.NRGBA {
+	out := image.NewNRGBA(c.out)
+	const n = supersampling * supersampling
+	for y := 0; y < c.out.Dy(); y++ {
+		for x := 0; x < c.out.Dx(); x++ {
+			var r, g, b, a uint32
+			for dy := 0; dy < supersampling; dy++ {
+				i := c.img.PixOffset(x*supersampling, y*supersampling+dy)
+				for dx := 0; dx < supersampling; dx++ {
+					r += uint32(c.img.Pix[i])
+					g += uint32(c.img.Pix[i+1])
+					b += uint32(c.img.Pix[i+2])
+					a += uint32(c.img.Pix[i+3])
+					i += 4
+				}
+			}
+			// Set converts the premultiplied average to non-premultiplied alpha
+			out.Set(x, y, color.RGBA{R: uint8(r / n), G: uint8(g / n), B: uint8(b / n), A: uint8(a / n)})
+		}
+	}
+	return out
+}
+
+func (c *pngCanvas) Rect(x, y, w, h float64, s drawStyle) {
+	if fill, ok := parseColor(s.Fill); ok {
+		c.fillRect(x*c.scale, y*c.scale, (x+w)*c.scale, (y+h)*c.scale, fill, s.FillOpacity)
+	}
+	if s.Stroke != "" {
+		c.Polyline([]point{{x, y}, {x + w, y}, {x + w, y + h}, {x, y + h}, {x, y}}, s)
+	}
+}
+
+func (c *pngCanvas) Line(x1, y1, x2, y2 float64, s drawStyle) {
+	c.Polyline([]point{{x1, y1}, {x2, y2}}, s)
+}
+
+func (c *pngCanvas) Polyline(points []point, s drawStyle) {
+	stroke, ok := parseColor(s.Stroke)
+	if !ok || s.StrokeWidth <= 0 || len(points) < 2 {
+		return
+	}
+
+	width := math.Max(s.StrokeWidth*c.scale, 1)
+	scaled := make([]point, len(points))
+	for i, p := range points {
+		scaled[i] = point{X: p.X * c.scale, Y: p.Y * c.scale}
+	}
+
+	pieces := [][]point{scaled}
+	if pattern := dashArray(s.Dash, s.StrokeWidth); pattern != nil {
+		for i := range pattern {
+			pattern[i] *= c.scale
+		}
+		pieces = dashPolyline(scaled, pattern)
+	}
+
+	for _, piece := range pieces {
+		for i := 1; i < len(piece); i++ {
+			c.strokeSegment(piece[i-1], piece[i], width, stroke, s.Opacity)
+			// Round joins
+			if i < len(piece)-1 && width > 2 {
+				c.fillCircle(piece[i].X, piece[i].Y, width/2, stroke, s.Opacity)
+			}
+		}
+	}
+}
+
+func (c *pngCanvas) Polygon(points []point, s drawStyle) {
+	if fill, ok := parseColor(s.Fill); ok {
+		scaled := make([]point, len(points))
+		for i, p := range points {
+			scaled[i] = point{X: p.X * c.scale, Y: p.Y * c.scale}
+		}
+		c.fillPolygon(scaled, fill, s.FillOpacity)
+	}
+	if s.Stroke != "" && len(points) > 0 {
+		c.Polyline(append(append([]point(nil), points...), points[0]), s)
+	}
+}
+
+func (c *pngCanvas) Circle(cx, cy, r float64, s drawStyle) {
+	x, y, radius := cx*c.scale, cy*c.scale, r*c.scale
+	if fill, ok := parseColor(s.Fill); ok {
+		c.fillCircle(x, y, radius, fill, s.FillOpacity)
+	}
+	if stroke, ok := parseColor(s.Stroke); ok && s.StrokeWidth > 0 {
+		width := math.Max(s.StrokeWidth*c.scale, 1)
+		c.fillRing(x, y, radius-width/2, radius+width/2, stroke, s.Opacity)
+	}
+}
+
+// Text draws text with the bitmap font. Glyph pixels are filled as squares,
+// rotated around the anchor for angled text.
+func (c *pngCanvas) Text(x, y float64, text string, s textStyle) {
+	col, ok := parseColor(s.Color)
+	if !ok || text == "" {
+		return
+	}
+
+	runes := []rune(text)
+	unit := s.Size * c.scale / glyphCell
+	width := float64(len(runes)*glyphAdvance-1) * unit
+
+	left := 0.0
+	switch s.Anchor {
+	case "middle":
+		left = -width / 2
+	case "end":
+		left = -width
+	}
+	top := -float64(glyphRows) * unit / 2
+
+	ox, oy := x*c.scale, y*c.scale
+	sin, cos := math.Sincos(s.Angle * math.Pi / 180)
+	transform := func(lx, ly float64) point {
+		return point{X: ox + lx*cos - ly*sin, Y: oy + lx*sin + ly*cos}
+	}
+
+	for i, r := range runes {
+		g := glyph(r)
+		for gx := 0; gx < glyphColumns; gx++ {
+			for gy := 0; gy < glyphRows; gy++ {
+				if g[gx]>>uint(gy)&1 == 0 {
+					continue
+				}
+				lx := left + float64(i*glyphAdvance+gx)*unit
+				ly := top + float64(gy)*unit
+				if s.Angle == 0 {
+					c.fillRect(ox+lx, oy+ly, ox+lx+unit, oy+ly+unit, col, 1)
+					continue
+				}
+				c.fillPolygon([]point{
+					transform(lx, ly),
+					transform(lx+unit, ly),
+					transform(lx+unit, ly+unit),
+					transform(lx, ly+unit),
+				}, col, 1)
+			}
+		}
+	}
+}
+
+func (c *pngCanvas) Clip(x, y, w, h float64) {
+	c.clip = image.Rect(
+		int(math.Round(x*c.scale)), int(math.Round(y*c.scale)),
+		int(math.Round((x+w)*c.scale)), int(math.Round((y+h)*c.scale)),
+	).Intersect(c.img.Bounds())
+}
+
+func (c *pngCanvas) Unclip() {
+	c.clip = c.img.Bounds()
+}
+
+// blend composites a color over pixel x, y with the given opacity
+func (c *pngCanvas) blend(x, y int, col color.NRGBA, opacity float64) {
+	if !(image.Point{X: x, Y: y}).In(c.clip) {
+		return
+	}
+	alpha := float64(col.A) / 255 * opacity
+	if alpha <= 0 {
+		return
+	}
+	i := c.img.PixOffset(x, y)
+	pix := c.img.Pix[i : i+4 : i+4]
+	pix[0] = uint8(float64(col.R)*alpha + float64(pix[0])*(1-alpha) + 0.5)
+	pix[1] = uint8(float64(col.G)*alpha + float64(pix[1])*(1-alpha) + 0.5)
+	pix[2] = uint8(float64(col.B)*alpha + float64(pix[2])*(1-alpha) + 0.5)
+	pix[3] = uint8(255*alpha + float64(pix[3])*(1-alpha) + 0.5)
+}
+
+// fillRect fills the pixels whose centers lie within a rectangle given in
+// pixel coordinates
+func (c *pngCanvas) fillRect(x0, y0, x1, y1 float64, col color.NRGBA, opacity float64) {
+	if x1 < x0 {
+		x0, x1 = x1, x0
+	}
+	if y1 < y0 {
+		y0, y1 = y1, y0
+	}
+	if x0 == x1 || y0 == y1 {
+		return
+	}
+	// Keep hairlines visible
+	if x1-x0 < 1 {
+		x0, x1 = (x0+x1)/2-0.5, (x0+x1)/2+0.5
+	}
+	if y1-y0 < 1 {
+		y0, y1 = (y0+y1)/2-0.5, (y0+y1)/2+0.5
+	}
+
+	bounds := image.Rect(
+		int(math.Round(x0)), int(math.Round(y0)),
+		int(math.Round(x1)), int(math.Round(y1)),
+	).Intersect(c.clip)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c.blend(x, y, col, opacity)
+		}
+	}
+}
+
+// fillPolygon fills a polygon given in pixel coordinates with the even-odd
+// rule, sampling at pixel centers
+func (c *pngCanvas) fillPolygon(points []point, col color.NRGBA, opacity float64) {
+	if len(points) < 3 {
+		return
+	}
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, p := range points {
+		minY, maxY = math.Min(minY, p.Y), math.Max(maxY, p.Y)
+	}
+	y0 := int(math.Max(math.Floor(minY), float64(c.clip.Min.Y)))
+	y1 := int(math.Min(math.Ceil(maxY), float64(c.clip.Max.Y-1)))
+
+	var crossings []float64
+	for y := y0; y <= y1; y++ {
+		cy := float64(y) + 0.5
+		crossings = crossings[:0]
+		for i := range points {
+			a, b := points[i], points[(i+1)%len(points)]
+			if (a.Y <= cy) == (b.Y <= cy) {
+				continue
+			}
+			crossings = append(crossings, a.X+(cy-a.Y)/(b.Y-a.Y)*(b.X-a.X))
+		}
+		sort.Float64s(crossings)
+		for i := 0; i+1 < len(crossings); i += 2 {
+			x0 := int(math.Max(math.Ceil(crossings[i]-0.5), float64(c.clip.Min.X)))
+			x1 := int(math.Min(math.Ceil(crossings[i+1]-0.5), float64(c.clip.Max.X)))
+			for x := x0; x < x1; x++ {
+				c.blend(x, y, col, opacity)
+			}
+		}
+	}
+}
+
+// strokeSegment fills the rectangle covered by a line segment of the given
+// width
+func (c *pngCanvas) strokeSegment(a, b point, width float64, col color.NRGBA, opacity float64) {
+	dx, dy := b.X-a.X, b.Y-a.Y
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return
+	}
+	if dx == 0 || dy == 0 {
+		// Axis-aligned lines, the common case for grids and ticks
+		half := width / 2
+		if dx == 0 {
+			c.fillRect(a.X-half, math.Min(a.Y, b.Y), a.X+half, math.Max(a.Y, b.Y), col, opacity)
+		} else {
+			c.fillRect(math.Min(a.X, b.X), a.Y-half, math.Max(a.X, b.X), a.Y+half, col, opacity)
+		}
+		return
+	}
+	nx, ny := -dy/length*width/2, dx/length*width/2
+	c.fillPolygon([]point{
+		{X: a.X + nx, Y: a.Y + ny},
+		{X: b.X + nx, Y: b.Y + ny},
+		{X: b.X - nx, Y: b.Y - ny},
+		{X: a.X - nx, Y: a.Y - ny},
+	}, col, opacity)
+}
+
+// fillCircle fills a circle given in pixel coordinates
+func (c *pngCanvas) fillCircle(cx, cy, r float64, col color.NRGBA, opacity float64) {
+	c.fillRing(cx, cy, 0, r, col, opacity)
+}
+
+// fillRing fills the pixels whose centers lie between two radii
+func (c *pngCanvas) fillRing(cx, cy, inner, outer float64, col color.NRGBA, opacity float64) {
+	outer = math.Max(outer, 0.5)
+	bounds := image.Rect(
+		int(math.Floor(cx-outer)), int(math.Floor(cy-outer)),
+		int(math.Ceil(cx+outer)), int(math.Ceil(cy+outer)),
+	).Intersect(c.clip)
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			d := math.Hypot(float64(x)+0.5-cx, float64(y)+0.5-cy)
+			if d <= outer && (inner <= 0 || d >= inner) {
+				c.blend(x, y, col, opacity)
+			}
+		}
+	}
+}
+
+// dashPolyline splits a polyline into the dashes of an on/off pattern
+func dashPolyline(points []point, pattern []float64) [][]point {
+	var dashes [][]point
+	var current []point
+	index, remaining, on := 0, pattern[0], true
+
+	for i := 1; i < len(points); i++ {
+		a, b := points[i-1], points[i]
+		length := math.Hypot(b.X-a.X, b.Y-a.Y)
+		pos := 0.0
+		if on && len(current) == 0 {
+			current = append(current, a)
+		}
+		for length-pos > remaining {
+			pos += remaining
+			p := point{X: a.X + (b.X-a.X)*pos/length, Y: a.Y + (b.Y-a.Y)*pos/length}
+			if on {
+				dashes = append(dashes, append(current, p))
+				current = nil
+			} else {
+				current = []point{p}
+			}
+			on = !on
+			index = (index + 1) % len(pattern)
+			remaining = pattern[index]
+		}
+		remaining -= length - pos
+		if on {
+			current = append(current, b)
+		}
+	}
+	if on && len(current) > 1 {
+		dashes = append(dashes, current)
+	}
+	return dashes
+}
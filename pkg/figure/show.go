@@ -0,0 +1,212 @@
+package figure
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ekinolik/go-plotly/pkg/plotlyjs"
+	"github.com/ekinolik/go-plotly/pkg/renderers"
+)
+
+// Figure is rendered through the renderers package
+var _ renderers.Figure = (*Figure)(nil)
+
+// DefaultShowTimeout is how long Show serves a figure when the page does not
+// report that it has loaded
+const DefaultShowTimeout = 30 * time.Second
+
+// Paths served by the Show server
+const (
+	showPagePath     = "/"
+	showPlotlyJSPath = "/plotly.min.js"
+	showLoadedPath   = "/loaded"
+)
+
+// showLoadedScript reports to the Show server that the page has loaded
+const showLoadedScript = `<script>window.addEventListener("load", function () { fetch("` + showLoadedPath + `", {method: "POST"}); });</script>`
+
+// ShowOptions configure Figure.ShowWithOptions
+type ShowOptions struct {
+	// Timeout is how long the server waits for the page to load
+	// (DefaultShowTimeout when zero)
+	Timeout time.Duration
+
+	// Output receives the URL of the plot when no browser can be opened
+	// (os.Stderr when nil)
+	Output io.Writer
+}
+
+// Show displays the figure with the default renderer, which is selected with
+// the GOPLOTLY_RENDERER environment variable or renderers.SetDefault (see
+// package renderers). By default the figure is opened in the browser like
+// ShowInBrowser.
+func (f *Figure) Show() error {
+	return renderers.Render(f)
+}
+
+// ShowInBrowser opens the figure in the default browser. The page is served
+// from memory by a local HTTP server, which shuts down once the page has
+// loaded or after DefaultShowTimeout.
+func (f *Figure) ShowInBrowser() error {
+	return f.ShowWithOptions(ShowOptions{})
+}
+
+// ShowWithOptions opens the figure in the default browser like ShowInBrowser.
+// If no browser can be opened, the URL is printed to opts.Output so that it
+// can be opened manually before the timeout.
+func (f *Figure) ShowWithOptions(opts ShowOptions) error {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultShowTimeout
+	}
+	if opts.Output == nil {
+		opts.Output = os.Stderr
+	}
+
+	page, bundle, err := f.showPage()
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("error starting server: %v", err)
+	}
+
+	loaded := make(chan struct{})
+	var once sync.Once
+	mux := http.NewServeMux()
+	mux.HandleFunc(showPagePath, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != showPagePath {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(page)
+	})
+	if bundle != nil {
+		mux.HandleFunc(showPlotlyJSPath, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/javascript")
+			w.Write(bundle)
+		})
+	}
+	mux.HandleFunc(showLoadedPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		once.Do(func() { close(loaded) })
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	served := make(chan error, 1)
+	go func() { served <- server.Serve(listener) }()
+
+	url := fmt.Sprintf("http://%s%s", listener.Addr(), showPagePath)
+	if err := openBrowser(url); err != nil {
+		fmt.Fprintf(opts.Output, "Open %s in a browser to view the plot\n", url)
+	}
+
+	timer := time.NewTimer(opts.Timeout)
+	defer timer.Stop()
+
+	var result error
+	select {
+	case <-loaded:
+	case <-timer.C:
+		result = fmt.Errorf("timed out after %v waiting for the plot to load", opts.Timeout)
+	case err := <-served:
+		return fmt.Errorf("error serving plot: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("error stopping server: %v", err)
+	}
+	return result
+}
+
+// showPage returns the page served by Show, and the plotly.js bundle when it
+// is available. Without the bundle, the page loads plotly.js from the CDN.
+func (f *Figure) showPage() ([]byte, []byte, error) {
+	opts := HTMLOptions{
+		IncludePlotlyJS: offlinePlotlyJSMode(),
+		ExtraHead:       template.HTML(showLoadedScript),
+	}
+
+	var bundle []byte
+	if opts.IncludePlotlyJS == PlotlyJSInline {
+		var err error
+		if bundle, err = plotlyjs.Bundle(); err != nil {
+			return nil, nil, err
+		}
+		opts.IncludePlotlyJS = PlotlyJSPath
+		opts.PlotlyJSPath = showPlotlyJSPath
+	}
+
+	var page bytes.Buffer
+	if err := f.WriteHTML(&page, opts); err != nil {
+		return nil, nil, fmt.Errorf("error generating HTML: %v", err)
+	}
+	return page.Bytes(), bundle, nil
+}
+
+// ShowFile writes the figure to an HTML file in a new temporary directory and
+// opens it in the default browser. The returned cleanup function removes the
+// directory; call it once the browser has loaded the page. If no browser can
+// be opened, the path is printed to os.Stderr.
+func (f *Figure) ShowFile() (string, func() error, error) {
+	path, cleanup, err := f.WriteTempHTML()
+	if err != nil {
+		return "", nil, err
+	}
+
+	url := filepath.ToSlash(path)
+	if !strings.HasPrefix(url, "/") {
+		url = "/" + url // Windows drive letters
+	}
+	if err := openBrowser("file://" + url); err != nil {
+		fmt.Fprintf(os.Stderr, "Open %s in a browser to view the plot\n", path)
+	}
+	return path, cleanup, nil
+}
+
+// WriteTempHTML writes the figure as a self-contained HTML file into a new
+// temporary directory. It returns the absolute path of the file and a
+// cleanup function that removes the directory.
+func (f *Figure) WriteTempHTML() (string, func() error, error) {
+	dir, err := os.MkdirTemp("", "go-plotly-")
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating temp directory: %v", err)
+	}
+	cleanup := func() error { return os.RemoveAll(dir) }
+
+	file, err := os.Create(filepath.Join(dir, "plot.html"))
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("error creating HTML file: %v", err)
+	}
+	err = f.WriteOfflineHTML(file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("error writing HTML file: %v", err)
+	}
+
+	path, err := filepath.Abs(file.Name())
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("error getting absolute path: %v", err)
+	}
+	return path, cleanup, nil
+}
@@ -0,0 +1,167 @@
+package figure
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ekinolik/go-plotly/pkg/renderers"
+	"github.com/stretchr/testify/assert"
+)
+
+// stubBrowser replaces openBrowser for the duration of a test
+func stubBrowser(t *testing.T, open func(url string) error) {
+	t.Helper()
+	original := openBrowser
+	openBrowser = open
+	t.Cleanup(func() { openBrowser = original })
+}
+
+func showFigure() *Figure {
+	fig := New()
+	fig.AddTrace(map[string]interface{}{
+		"type": "scatter",
+		"x":    []int{1, 2, 3},
+		"y":    []int{4, 5, 6},
+	})
+	return fig
+}
+
+func TestShowServesPage(t *testing.T) {
+	fakePlotlyJS(t)
+	var page string
+	browserErr := make(chan error, 1)
+	stubBrowser(t, func(url string) error {
+		assert.True(t, strings.HasPrefix(url, "http://127.0.0.1:"))
+
+		// Load the page and report it as loaded like the page script does
+		go func() {
+			resp, err := http.Get(url)
+			if err != nil {
+				browserErr <- err
+				return
+			}
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			page = string(body)
+
+			resp, err = http.Get(strings.TrimSuffix(url, "/") + "/missing")
+			if err == nil {
+				resp.Body.Close()
+				if resp.StatusCode != http.StatusNotFound {
+					err = errors.New("unexpected status for unknown path: " + resp.Status)
+				}
+			}
+			if err != nil {
+				browserErr <- err
+				return
+			}
+
+			resp, err = http.Post(strings.TrimSuffix(url, "/")+showLoadedPath, "text/plain", nil)
+			if err == nil {
+				resp.Body.Close()
+			}
+			browserErr <- err
+		}()
+		return nil
+	})
+
+	var output bytes.Buffer
+	start := time.Now()
+	err := showFigure().ShowWithOptions(ShowOptions{Timeout: 10 * time.Second, Output: &output})
+	assert.NoError(t, err)
+	assert.NoError(t, <-browserErr)
+	assert.Less(t, time.Since(start), 5*time.Second, "server should stop once the page has loaded")
+
+	assert.Contains(t, page, "Plotly.newPlot(")
+	assert.Contains(t, page, showLoadedPath)
+	assert.Contains(t, page, `<script src="`+showPlotlyJSPath+`"`)
+	assert.Empty(t, output.String(), "URL is only printed when no browser can be opened")
+}
+
+func TestShowTimeout(t *testing.T) {
+	fakePlotlyJS(t)
+	stubBrowser(t, func(url string) error {
+		return errors.New("no browser")
+	})
+
+	var output bytes.Buffer
+	err := showFigure().ShowWithOptions(ShowOptions{Timeout: 50 * time.Millisecond, Output: &output})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+	assert.Contains(t, output.String(), "http://127.0.0.1:")
+}
+
+func TestWriteTempHTML(t *testing.T) {
+	bundle := fakePlotlyJS(t)
+	path, cleanup, err := showFigure().WriteTempHTML()
+	assert.NoError(t, err)
+	assert.True(t, filepath.IsAbs(path))
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "Plotly.newPlot(")
+	assert.Contains(t, string(content), bundle)
+
+	assert.NoError(t, cleanup())
+	_, err = os.Stat(filepath.Dir(path))
+	assert.True(t, os.IsNotExist(err), "cleanup should remove the temporary directory")
+}
+
+func TestShowFile(t *testing.T) {
+	fakePlotlyJS(t)
+	var opened string
+	stubBrowser(t, func(url string) error {
+		opened = url
+		return nil
+	})
+
+	path, cleanup, err := showFigure().ShowFile()
+	assert.NoError(t, err)
+	defer cleanup()
+
+	assert.Equal(t, "file://"+filepath.ToSlash(path), opened)
+	_, err = os.Stat(path)
+	assert.NoError(t, err)
+}
+
+func TestShowUsesRenderer(t *testing.T) {
+	var rendered renderers.Figure
+	renderers.Register("test", renderers.RendererFunc(func(fig renderers.Figure) error {
+		rendered = fig
+		return nil
+	}))
+	t.Setenv(renderers.EnvVar, "test")
+
+	fig := showFigure()
+	assert.NoError(t, fig.Show())
+	assert.Same(t, fig, rendered)
+
+	t.Setenv(renderers.EnvVar, renderers.None)
+	stubBrowser(t, func(url string) error {
+		t.Fatal("none renderer should not open a browser")
+		return nil
+	})
+	assert.NoError(t, fig.Show())
+}
+
+func TestHTMLRendererIsOffline(t *testing.T) {
+	bundle := fakePlotlyJS(t)
+
+	dir := t.TempDir()
+	var output bytes.Buffer
+	renderer := &renderers.FileRenderer{Format: renderers.HTML, Dir: dir, Output: &output}
+	assert.NoError(t, renderer.Render(showFigure()))
+
+	path := strings.TrimSpace(strings.TrimPrefix(output.String(), "Plot saved to: "))
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), bundle, "plotly.js should be inlined")
+	assert.NotContains(t, string(content), "<script src=")
+}
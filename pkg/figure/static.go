@@ -0,0 +1,1231 @@
+package figure
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+// Static export renders figures without a browser. The chart is laid out once
+// and drawn onto a canvas, which is implemented for SVG and raster output.
+
+// Default layout values used by static export, matching the plotly.js defaults
+const (
+	defaultWidth      = 700
+	defaultHeight     = 450
+	defaultFontFamily = `"Open Sans", verdana, arial, sans-serif`
+	defaultFontSize   = 12
+	defaultFontColor  = "#444"
+	defaultTitleSize  = 17
+	defaultGridColor  = "#eee"
+	defaultLineColor  = "#444"
+	defaultBgColor    = "#fff"
+)
+
+var defaultColorway = []string{
+	"#1f77b4", "#ff7f0e", "#2ca02c", "#d62728", "#9467bd",
+	"#8c564b", "#e377c2", "#7f7f7f", "#bcbd22", "#17becf",
+}
+
+var defaultMargin = graph_objects.Margin{L: 80, R: 80, T: 100, B: 80}
+
+// point is a position in canvas coordinates
+type point struct {
+	X, Y float64
+}
+
+// drawStyle describes how a shape is filled and stroked. Empty colors are not
+// drawn.
+type drawStyle struct {
+	Fill        string
+	FillOpacity float64
+	Stroke      string
+	StrokeWidth float64
+	Opacity     float64 // stroke opacity
+	Dash        string
+}
+
+// textStyle describes how text is drawn
+type textStyle struct {
+	Family string
+	Size   float64
+	Color  string
+	Anchor string  // "start", "middle" or "end"
+	Angle  float64 // clockwise rotation in degrees around the anchor
+}
+
+// canvas is the drawing surface of static export. Text is positioned by its
+// anchor and vertical center.
+type canvas interface {
+	Rect(x, y, w, h float64, s drawStyle)
+	Line(x1, y1, x2, y2 float64, s drawStyle)
+	Polyline(points []point, s drawStyle)
+	Polygon(points []point, s drawStyle)
+	Circle(cx, cy, r float64, s drawStyle)
+	Text(x, y float64, text string, s textStyle)
+	Clip(x, y, w, h float64)
+	Unclip()
+}
+
+// fillStyle returns a style that fills with the given color and opacity
+func fillStyle(color string, opacity float64) drawStyle {
+	return drawStyle{Fill: color, FillOpacity: opacity}
+}
+
+// strokeStyle returns a style that strokes with the given color and width
+func strokeStyle(color string, width float64) drawStyle {
+	return drawStyle{Stroke: color, StrokeWidth: width, Opacity: 1}
+}
+
+// textWidth estimates the rendered width of text
+func textWidth(text string, size float64) float64 {
+	return float64(len([]rune(text))) * size * 0.6
+}
+
+// staticChart is a figure laid out for static export
+type staticChart struct {
+	width, height float64
+	layout        *graph_objects.Layout
+	font          textStyle
+	series        []*series
+
+	// Plot area
+	plotX, plotY, plotW, plotH float64
+
+	xaxis, yaxis *axis
+	legend       []*series
+	showLegend   bool
+	legendWidth  float64
+}
+
+// series is a trace prepared for static export
+type series struct {
+	name       string
+	color      string
+	showLegend bool
+	hidden     bool // visible: "legendonly"
+
+	// Raw values for the autorange of each axis
+	xs, ys       []interface{}
+	xZero, yZero bool // include zero in the autorange
+
+	// Half-width of the marks drawn at each x position, as a fraction of
+	// the smallest position spacing, added to the x autorange
+	xSpread float64
+
+	draw   func(c canvas, x, y *axis)
+	swatch func(c canvas, x, y float64)
+}
+
+// newStaticChart lays out the figure for static export. Width and height
+// override the layout size when positive.
+func (f *Figure) newStaticChart(width, height float64) (*staticChart, error) {
+	layout, err := staticLayout(f.Layout)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := &staticChart{
+		width:  layout.Width,
+		height: layout.Height,
+		layout: layout,
+		font: textStyle{
+			Family: defaultFontFamily,
+			Size:   defaultFontSize,
+			Color:  defaultFontColor,
+			Anchor: "middle",
+		},
+	}
+	if width > 0 {
+		ch.width = width
+	}
+	if height > 0 {
+		ch.height = height
+	}
+	if ch.width == 0 {
+		ch.width = defaultWidth
+	}
+	if ch.height == 0 {
+		ch.height = defaultHeight
+	}
+	ch.font = mergeFont(ch.font, layout.Font)
+
+	traces, err := staticTraces(f.Data)
+	if err != nil {
+		return nil, err
+	}
+	if ch.series, err = buildSeries(traces, layout, ch.font); err != nil {
+		return nil, err
+	}
+
+	ch.layoutLegend()
+	ch.layoutPlotArea()
+	ch.layoutAxes()
+	return ch, nil
+}
+
+// staticLayout converts the figure layout to a typed layout
+func staticLayout(layout interface{}) (*graph_objects.Layout, error) {
+	switch l := layout.(type) {
+	case nil:
+		return &graph_objects.Layout{}, nil
+	case *graph_objects.Layout:
+		if l == nil {
+			return &graph_objects.Layout{}, nil
+		}
+		return l, nil
+	}
+
+	data, err := json.Marshal(layout)
+	if err != nil {
+		return nil, err
+	}
+	typed := &graph_objects.Layout{}
+	if err := json.Unmarshal(data, typed); err != nil {
+		return nil, fmt.Errorf("error reading layout: %v", err)
+	}
+	return typed, nil
+}
+
+// staticTraces converts the figure data to typed traces
+func staticTraces(data []interface{}) ([]graph_objects.Trace, error) {
+	traces := make([]graph_objects.Trace, 0, len(data))
+	for i, trace := range data {
+		if trace == nil {
+			return nil, fmt.Errorf("trace %d is nil", i)
+		}
+		if typed, ok := trace.(graph_objects.Trace); ok {
+			traces = append(traces, typed)
+			continue
+		}
+		raw, err := json.Marshal(trace)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding trace %d: %v", i, err)
+		}
+		typed, err := graph_objects.DecodeTrace(raw)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding trace %d: %v", i, err)
+		}
+		traces = append(traces, typed)
+	}
+	return traces, nil
+}
+
+// mergeFont applies the set properties of a layout font to a text style
+func mergeFont(s textStyle, font *graph_objects.Font) textStyle {
+	if font == nil {
+		return s
+	}
+	if font.Family != "" {
+		s.Family = font.Family
+	}
+	if font.Size > 0 {
+		s.Size = font.Size
+	}
+	if color, ok := font.Color.(string); ok && color != "" {
+		s.Color = color
+	}
+	return s
+}
+
+// layoutLegend decides whether the legend is shown and measures it
+func (ch *staticChart) layoutLegend() {
+	for _, s := range ch.series {
+		if s.showLegend {
+			ch.legend = append(ch.legend, s)
+		}
+	}
+
+	ch.showLegend = len(ch.legend) > 1
+	if ch.layout.ShowLegend != nil {
+		ch.showLegend = *ch.layout.ShowLegend && len(ch.legend) > 0
+	}
+	if !ch.showLegend {
+		return
+	}
+
+	font := ch.legendFont()
+	for _, s := range ch.legend {
+		if w := textWidth(s.name, font.Size); w > ch.legendWidth {
+			ch.legendWidth = w
+		}
+	}
+	ch.legendWidth += 50
+}
+
+func (ch *staticChart) legendFont() textStyle {
+	font := ch.font
+	font.Anchor = "start"
+	if ch.layout.Legend != nil {
+		font = mergeFont(font, ch.layout.Legend.Font)
+	}
+	return font
+}
+
+func (ch *staticChart) horizontalLegend() bool {
+	return ch.layout.Legend != nil &&
+		ch.layout.Legend.Orientation == string(graph_objects.LegendOrientationHorizontal)
+}
+
+// layoutPlotArea computes the plot area from the margins and legend
+func (ch *staticChart) layoutPlotArea() {
+	margin := defaultMargin
+	if m := ch.layout.Margin; m != nil {
+		if m.L > 0 {
+			margin.L = m.L
+		}
+		if m.R > 0 {
+			margin.R = m.R
+		}
+		if m.T > 0 {
+			margin.T = m.T
+		}
+		if m.B > 0 {
+			margin.B = m.B
+		}
+	}
+
+	if ch.showLegend {
+		if ch.horizontalLegend() {
+			margin.B += ch.legendFont().Size * 2
+		} else {
+			margin.R = math.Max(margin.R, ch.legendWidth+20)
+		}
+	}
+
+	ch.plotX = margin.L
+	ch.plotY = margin.T
+	ch.plotW = math.Max(ch.width-margin.L-margin.R, 1)
+	ch.plotH = math.Max(ch.height-margin.T-margin.B, 1)
+}
+
+// layoutAxes builds the axes from the data of the visible series
+func (ch *staticChart) layoutAxes() {
+	var xs, ys []interface{}
+	xZero, yZero := false, false
+	xSpread := 0.0
+	for _, s := range ch.series {
+		if s.hidden {
+			continue
+		}
+		xs = append(xs, s.xs...)
+		ys = append(ys, s.ys...)
+		xZero = xZero || s.xZero
+		yZero = yZero || s.yZero
+		xSpread = math.Max(xSpread, s.xSpread)
+	}
+
+	ch.xaxis = newAxis(ch.layout.XAxis, xs, xZero)
+	ch.xaxis.widen(xSpread * ch.xaxis.spacing(xs))
+	ch.xaxis.setPixels(ch.plotX, ch.plotX+ch.plotW)
+	ch.yaxis = newAxis(ch.layout.YAxis, ys, yZero)
+	ch.yaxis.setPixels(ch.plotY+ch.plotH, ch.plotY)
+}
+
+// draw renders the chart onto the canvas
+func (ch *staticChart) draw(c canvas) {
+	paper := ch.layout.PaperBgColor
+	if paper == "" {
+		paper = defaultBgColor
+	}
+	plot := ch.layout.PlotBgColor
+	if plot == "" {
+		plot = defaultBgColor
+	}
+	c.Rect(0, 0, ch.width, ch.height, fillStyle(paper, 1))
+	c.Rect(ch.plotX, ch.plotY, ch.plotW, ch.plotH, fillStyle(plot, 1))
+
+	xTicks := ch.xaxis.ticks(ch.plotW / 80)
+	yTicks := ch.yaxis.ticks(ch.plotH / 50)
+
+	ch.drawGrid(c, ch.xaxis, xTicks, true)
+	ch.drawGrid(c, ch.yaxis, yTicks, false)
+
+	c.Clip(ch.plotX, ch.plotY, ch.plotW, ch.plotH)
+	for _, s := range ch.series {
+		if !s.hidden {
+			s.draw(c, ch.xaxis, ch.yaxis)
+		}
+	}
+	c.Unclip()
+
+	ch.drawAxis(c, ch.xaxis, xTicks, true)
+	ch.drawAxis(c, ch.yaxis, yTicks, false)
+	ch.drawTitle(c)
+	if ch.showLegend {
+		ch.drawLegend(c)
+	}
+}
+
+// drawGrid draws the grid and zero lines of an axis
+func (ch *staticChart) drawGrid(c canvas, a *axis, ticks []tick, horizontal bool) {
+	line := func(pos float64, s drawStyle) {
+		if horizontal {
+			c.Line(pos, ch.plotY, pos, ch.plotY+ch.plotH, s)
+		} else {
+			c.Line(ch.plotX, pos, ch.plotX+ch.plotW, pos, s)
+		}
+	}
+
+	if a.showGrid() {
+		color, width := defaultGridColor, 1.0
+		if a.layout != nil {
+			if a.layout.GridColor != "" {
+				color = a.layout.GridColor
+			}
+			if a.layout.GridWidth > 0 {
+				width = a.layout.GridWidth
+			}
+		}
+		for _, t := range ticks {
+			line(t.pixel, strokeStyle(color, width))
+		}
+	}
+
+	if a.showZeroLine() {
+		color, width := defaultLineColor, 1.0
+		if a.layout != nil {
+			if a.layout.ZeroLineColor != "" {
+				color = a.layout.ZeroLineColor
+			}
+			if a.layout.ZeroLineWidth > 0 {
+				width = a.layout.ZeroLineWidth
+			}
+		}
+		line(a.pixel(0), strokeStyle(color, width))
+	}
+}
+
+// drawAxis draws the line, tick labels and title of an axis
+func (ch *staticChart) drawAxis(c canvas, a *axis, ticks []tick, horizontal bool) {
+	font := ch.font
+	if a.layout != nil {
+		font = mergeFont(font, a.layout.TickFont)
+	}
+
+	if a.layout != nil && a.layout.ShowLine != nil && *a.layout.ShowLine {
+		color, width := defaultLineColor, 1.0
+		if a.layout.LineColor != "" {
+			color = a.layout.LineColor
+		}
+		if a.layout.LineWidth > 0 {
+			width = a.layout.LineWidth
+		}
+		if horizontal {
+			c.Line(ch.plotX, ch.plotY+ch.plotH, ch.plotX+ch.plotW, ch.plotY+ch.plotH, strokeStyle(color, width))
+		} else {
+			c.Line(ch.plotX, ch.plotY, ch.plotX, ch.plotY+ch.plotH, strokeStyle(color, width))
+		}
+	}
+
+	showLabels := a.layout == nil || a.layout.ShowTickLabels == nil || *a.layout.ShowTickLabels
+	angle := a.tickAngle()
+	labelExtent := 0.0
+	if showLabels {
+		for _, t := range ticks {
+			label := font
+			label.Angle = angle
+			if horizontal {
+				y := ch.plotY + ch.plotH + 6 + font.Size/2
+				if angle != 0 {
+					label.Anchor = "end"
+					if angle > 0 {
+						label.Anchor = "start"
+					}
+				}
+				c.Text(t.pixel, y, t.label, label)
+				extent := font.Size
+				if angle != 0 {
+					extent = math.Abs(math.Sin(angle*math.Pi/180))*textWidth(t.label, font.Size) + font.Size
+				}
+				labelExtent = math.Max(labelExtent, extent)
+			} else {
+				label.Anchor = "end"
+				c.Text(ch.plotX-6, t.pixel, t.label, label)
+				labelExtent = math.Max(labelExtent, textWidth(t.label, font.Size))
+			}
+		}
+	}
+
+	if a.layout == nil || a.layout.Title == nil || a.layout.Title.Text == "" {
+		return
+	}
+	title := mergeFont(ch.font, a.layout.Title.Font)
+	title.Anchor = "middle"
+	if horizontal {
+		y := ch.plotY + ch.plotH + 6 + labelExtent + 8 + title.Size/2
+		c.Text(ch.plotX+ch.plotW/2, y, a.layout.Title.Text, title)
+	} else {
+		title.Angle = -90
+		x := ch.plotX - 6 - labelExtent - 8 - title.Size/2
+		c.Text(x, ch.plotY+ch.plotH/2, a.layout.Title.Text, title)
+	}
+}
+
+// drawTitle draws the figure title
+func (ch *staticChart) drawTitle(c canvas) {
+	t := ch.layout.Title
+	if t == nil || t.Text == "" {
+		return
+	}
+
+	font := ch.font
+	font.Size = defaultTitleSize
+	font = mergeFont(font, t.Font)
+	font.Anchor = "middle"
+
+	x := ch.width / 2
+	if t.X != nil {
+		x = *t.X * ch.width
+		font.Anchor = "start"
+	}
+	switch t.XAnchor {
+	case "left":
+		font.Anchor = "start"
+	case "center":
+		font.Anchor = "middle"
+	case "right":
+		font.Anchor = "end"
+	}
+
+	y := ch.plotY / 2
+	if t.Y != nil {
+		y = (1 - *t.Y) * ch.height
+	}
+	c.Text(x, y, t.Text, font)
+}
+
+// drawLegend draws the legend entries of the shown series
+func (ch *staticChart) drawLegend(c canvas) {
+	font := ch.legendFont()
+	rowHeight := font.Size * 1.6
+
+	if lg := ch.layout.Legend; lg != nil && lg.BgColor != "" {
+		w, h := ch.legendWidth, rowHeight*float64(len(ch.legend))+10
+		if ch.horizontalLegend() {
+			w, h = ch.plotW, rowHeight+10
+		}
+		x, y := ch.legendOrigin()
+		c.Rect(x-5, y-5, w, h, fillStyle(lg.BgColor, 1))
+	}
+
+	x, y := ch.legendOrigin()
+	for _, s := range ch.legend {
+		cy := y + rowHeight/2
+		s.swatch(c, x+20, cy)
+		label := font
+		if s.hidden {
+			label.Color = "#bbb"
+		}
+		c.Text(x+45, cy, s.name, label)
+		if ch.horizontalLegend() {
+			x += 55 + textWidth(s.name, font.Size)
+		} else {
+			y += rowHeight
+		}
+	}
+}
+
+// legendOrigin returns the top left corner of the legend
+func (ch *staticChart) legendOrigin() (float64, float64) {
+	if ch.horizontalLegend() {
+		return ch.plotX, ch.height - ch.legendFont().Size*2 - 10
+	}
+
+	x, y := ch.plotX+ch.plotW+10, ch.plotY
+	if lg := ch.layout.Legend; lg != nil {
+		if lg.X != nil {
+			x = ch.plotX + *lg.X*ch.plotW
+		}
+		if lg.Y != nil {
+			y = ch.plotY + (1-*lg.Y)*ch.plotH
+		}
+	}
+	return x, y
+}
+
+// axisKind is the scale type of an axis
+type axisKind int
+
+const (
+	axisLinear axisKind = iota
+	axisLog
+	axisDate
+	axisCategory
+)
+
+// axis maps data values to canvas coordinates
+type axis struct {
+	kind       axisKind
+	layout     *graph_objects.Axis
+	min, max   float64 // in axis units (log10 for log axes, ms for dates)
+	categories []string
+	index      map[string]int
+
+	// Canvas coordinates of min and max
+	start, end float64
+}
+
+// tick is a labeled position on an axis
+type tick struct {
+	pixel float64
+	label string
+}
+
+// newAxis builds an axis for the raw values of the series drawn on it
+func newAxis(layout *graph_objects.Axis, values []interface{}, includeZero bool) *axis {
+	a := &axis{
+		layout: layout,
+		kind:   detectAxisKind(layout, values),
+		index:  make(map[string]int),
+	}
+
+	if a.kind == axisCategory {
+		for _, v := range values {
+			if v == nil {
+				continue
+			}
+			key := categoryKey(v)
+			if _, exists := a.index[key]; !exists {
+				a.index[key] = len(a.categories)
+				a.categories = append(a.categories, key)
+			}
+		}
+		a.min, a.max = -0.5, math.Max(float64(len(a.categories))-0.5, 0.5)
+	} else {
+		a.min, a.max = math.Inf(1), math.Inf(-1)
+		for _, v := range values {
+			if n, ok := a.value(v); ok {
+				a.min = math.Min(a.min, n)
+				a.max = math.Max(a.max, n)
+			}
+		}
+		if math.IsInf(a.min, 1) {
+			a.min, a.max = 0, 1
+		}
+		if includeZero && a.kind == axisLinear {
+			a.min = math.Min(a.min, 0)
+			a.max = math.Max(a.max, 0)
+		}
+		a.pad(includeZero)
+	}
+
+	// An explicit range overrides the autorange
+	if layout != nil && len(layout.Range) == 2 {
+		lo, okLo := a.rangeValue(layout.Range[0])
+		hi, okHi := a.rangeValue(layout.Range[1])
+		if okLo && okHi && lo != hi {
+			a.min, a.max = lo, hi
+		}
+	}
+	return a
+}
+
+// detectAxisKind returns the axis type from the layout or, when not set,
+// from the data the way plotly.js autotypes axes
+func detectAxisKind(layout *graph_objects.Axis, values []interface{}) axisKind {
+	if layout != nil {
+		switch layout.Type {
+		case string(graph_objects.AxisTypeLinear):
+			return axisLinear
+		case string(graph_objects.AxisTypeLog):
+			return axisLog
+		case string(graph_objects.AxisTypeDate):
+			return axisDate
+		case string(graph_objects.AxisTypeCategory), string(graph_objects.AxisTypeMultiCategory):
+			return axisCategory
+		}
+	}
+
+	numeric, dates, strings := true, true, false
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		if _, ok := toNumber(v); ok {
+			dates = false
+			continue
+		}
+		numeric = false
+		switch d := v.(type) {
+		case time.Time:
+		case string:
+			strings = true
+			if _, ok := parseDate(d); !ok {
+				dates = false
+			}
+		default:
+			dates = false
+		}
+	}
+
+	switch {
+	case numeric:
+		return axisLinear
+	case dates && (strings || len(values) > 0):
+		return axisDate
+	default:
+		return axisCategory
+	}
+}
+
+// pad widens a numeric range by 5% so that data does not touch the edges
+func (a *axis) pad(includeZero bool) {
+	if a.min == a.max {
+		delta := math.Abs(a.min) * 0.5
+		if delta == 0 {
+			delta = 1
+		}
+		if a.kind == axisDate {
+			delta = float64(24 * time.Hour / time.Millisecond)
+		}
+		a.min -= delta
+		a.max += delta
+		return
+	}
+
+	pad := (a.max - a.min) * 0.05
+	if !(includeZero && a.min == 0) {
+		a.min -= pad
+	}
+	if !(includeZero && a.max == 0) {
+		a.max += pad
+	}
+}
+
+// widen extends the autorange of a numeric or date axis on both sides, for
+// marks that are drawn wider than their position
+func (a *axis) widen(units float64) {
+	if units <= 0 || a.kind == axisCategory || (a.layout != nil && len(a.layout.Range) == 2) {
+		return
+	}
+	a.min -= units
+	a.max += units
+}
+
+// setPixels sets the canvas coordinates of the range of the axis
+func (a *axis) setPixels(start, end float64) {
+	a.start, a.end = start, end
+	if a.layout != nil && a.layout.AutoRange == "reversed" {
+		a.start, a.end = end, start
+	}
+}
+
+// value converts a raw data value to axis units
+func (a *axis) value(v interface{}) (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+	switch a.kind {
+	case axisCategory:
+		i, ok := a.index[categoryKey(v)]
+		if !ok {
+			if n, isNumber := toNumber(v); isNumber {
+				return n, true
+			}
+		}
+		return float64(i), ok
+	case axisDate:
+		switch d := v.(type) {
+		case time.Time:
+			return float64(d.UnixMilli()), true
+		case string:
+			if t, ok := parseDate(d); ok {
+				return float64(t.UnixMilli()), true
+			}
+			return 0, false
+		}
+		return toNumber(v)
+	case axisLog:
+		n, ok := toNumber(v)
+		if !ok || n <= 0 {
+			return 0, false
+		}
+		return math.Log10(n), true
+	default:
+		return toNumber(v)
+	}
+}
+
+// rangeValue converts a layout range value to axis units. Log axis ranges are
+// given as exponents, like in plotly.js.
+func (a *axis) rangeValue(v interface{}) (float64, bool) {
+	switch a.kind {
+	case axisLog, axisCategory:
+		return toNumber(v)
+	}
+	return a.value(v)
+}
+
+// pixel converts axis units to canvas coordinates
+func (a *axis) pixel(v float64) float64 {
+	return a.start + (v-a.min)/(a.max-a.min)*(a.end-a.start)
+}
+
+// position converts a raw data value to canvas coordinates
+func (a *axis) position(v interface{}) (float64, bool) {
+	n, ok := a.value(v)
+	if !ok {
+		return 0, false
+	}
+	return a.pixel(n), true
+}
+
+// length returns the canvas length of one axis unit
+func (a *axis) length(units float64) float64 {
+	return math.Abs(units / (a.max - a.min) * (a.end - a.start))
+}
+
+func (a *axis) showGrid() bool {
+	if a.layout != nil && a.layout.ShowGrid != nil {
+		return *a.layout.ShowGrid
+	}
+	return true
+}
+
+func (a *axis) showZeroLine() bool {
+	if a.kind != axisLinear || a.min >= 0 || a.max <= 0 {
+		return false
+	}
+	if a.layout != nil && a.layout.ZeroLine != nil {
+		return *a.layout.ZeroLine
+	}
+	return true
+}
+
+func (a *axis) tickAngle() float64 {
+	if a.layout == nil {
+		return 0
+	}
+	angle, ok := toNumber(a.layout.TickAngle)
+	if !ok {
+		return 0
+	}
+	return angle
+}
+
+// maxTicks bounds the number of ticks drawn on an axis, so a tick step that
+// is tiny for the axis range cannot stall the export
+const maxTicks = 1000
+
+// ticks returns about target ticks within the range of the axis
+func (a *axis) ticks(target float64) []tick {
+	target = math.Max(target, 2)
+	lo, hi := math.Min(a.min, a.max), math.Max(a.min, a.max)
+	if math.IsNaN(hi-lo) || math.IsInf(hi-lo, 0) {
+		return nil
+	}
+
+	var ticks []tick
+	add := func(v float64, label string) {
+		if v < lo-1e-9*(hi-lo) || v > hi+1e-9*(hi-lo) {
+			return
+		}
+		if a.layout != nil {
+			label = a.layout.TickPrefix + label + a.layout.TickSuffix
+		}
+		ticks = append(ticks, tick{pixel: a.pixel(v), label: label})
+	}
+
+	// Explicit tick values
+	if a.layout != nil && a.layout.TickVals != nil {
+		vals := toValues(a.layout.TickVals)
+		texts := toValues(a.layout.TickText)
+		for i, raw := range vals {
+			v, ok := a.value(raw)
+			if !ok {
+				continue
+			}
+			label := a.formatValue(v, 0)
+			if i < len(texts) {
+				label = fmt.Sprint(texts[i])
+			}
+			add(v, label)
+		}
+		return ticks
+	}
+
+	switch a.kind {
+	case axisCategory:
+		step := int(math.Ceil(float64(len(a.categories)) / (target * 2)))
+		if step < 1 {
+			step = 1
+		}
+		for i := 0; i < len(a.categories); i += step {
+			add(float64(i), a.categories[i])
+		}
+	case axisDate:
+		for _, t := range dateTicks(lo, hi, target) {
+			add(t.value, t.label)
+		}
+	case axisLog:
+		if hi-lo >= 1 {
+			step := math.Max(1, math.Round((hi-lo)/target))
+			for e, n := math.Ceil(lo), 0; e <= hi && n < maxTicks; e, n = e+step, n+1 {
+				add(e, a.formatValue(math.Pow(10, e), 0))
+			}
+			return ticks
+		}
+		step := niceStep((hi - lo) / target)
+		for v, n := math.Ceil(lo/step)*step, 0; v <= hi && n < maxTicks; v, n = v+step, n+1 {
+			add(v, a.formatValue(math.Pow(10, v), 0))
+		}
+	default:
+		step := niceStep((hi - lo) / target)
+		if a.layout != nil {
+			// A tick step giving more than maxTicks ticks falls back to the
+			// computed step
+			if dtick, ok := toNumber(a.layout.DTick); ok && dtick > 0 && (hi-lo)/dtick <= maxTicks {
+				step = dtick
+			}
+		}
+		for i, n := math.Ceil(lo/step), 0; i*step <= hi && n < maxTicks; i, n = i+1, n+1 {
+			add(i*step, a.formatValue(i*step, step))
+		}
+	}
+	return ticks
+}
+
+// formatValue formats a tick value using the axis tick format, or with
+// enough precision for the tick step
+func (a *axis) formatValue(v, step float64) string {
+	if a.layout != nil && a.layout.TickFormat != "" {
+		if label, ok := formatD3(v, a.layout.TickFormat); ok {
+			return label
+		}
+	}
+	return formatNumber(v, step)
+}
+
+// formatNumber formats a number the way plotly.js formats default ticks,
+// using SI suffixes when the tick step is large. A zero step formats a single
+// value.
+func formatNumber(v, step float64) string {
+	if math.Abs(v) < 1e-12 {
+		return "0"
+	}
+	suffixes := []struct {
+		scale  float64
+		suffix string
+	}{
+		{1e9, "B"},
+		{1e6, "M"},
+		{1e3, "k"},
+	}
+	for _, s := range suffixes {
+		if (step > 0 && step >= s.scale) || (step == 0 && math.Abs(v) >= s.scale*10) {
+			return formatNumber(v/s.scale, step/s.scale) + s.suffix
+		}
+	}
+
+	if step == 0 {
+		return strconv.FormatFloat(v, 'g', 6, 64)
+	}
+	// Use as many decimals as the step needs
+	decimals := 0
+	for scaled := step; decimals < 6 && math.Abs(scaled-math.Round(scaled)) > 1e-9*math.Max(1, scaled); decimals++ {
+		scaled *= 10
+	}
+	return strconv.FormatFloat(v, 'f', decimals, 64)
+}
+
+// formatD3 formats a number with the common subset of d3-format specifiers
+// used for ticks: optional "," grouping and a precision with "f" or "%"
+func formatD3(v float64, format string) (string, bool) {
+	group := strings.Contains(format, ",")
+	format = strings.Replace(format, ",", "", 1)
+	if !strings.HasPrefix(format, ".") || len(format) < 3 {
+		return "", false
+	}
+	precision, err := strconv.Atoi(format[1 : len(format)-1])
+	if err != nil {
+		return "", false
+	}
+
+	suffix := ""
+	switch format[len(format)-1] {
+	case 'f':
+	case '%':
+		v *= 100
+		suffix = "%"
+	default:
+		return "", false
+	}
+
+	s := strconv.FormatFloat(v, 'f', precision, 64)
+	if group {
+		s = groupThousands(s)
+	}
+	return s + suffix, true
+}
+
+// groupThousands inserts "," thousands separators into a formatted number
+func groupThousands(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, frac := s, ""
+	if i := strings.Index(s, "."); i >= 0 {
+		intPart, frac = s[:i], s[i:]
+	}
+	var b strings.Builder
+	for i, r := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	return sign + b.String() + frac
+}
+
+// niceStep rounds a raw tick step to the nearest 1, 2 or 5 times a power of
+// ten
+func niceStep(raw float64) float64 {
+	if raw <= 0 || math.IsNaN(raw) || math.IsInf(raw, 0) {
+		return 1
+	}
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	switch r := raw / magnitude; {
+	case r < 1.5:
+		return magnitude
+	case r < 3:
+		return 2 * magnitude
+	case r < 7:
+		return 5 * magnitude
+	default:
+		return 10 * magnitude
+	}
+}
+
+// dateTick is a tick of a date axis, in milliseconds
+type dateTick struct {
+	value float64
+	label string
+}
+
+// dateTicks returns ticks for a date range given in unix milliseconds
+func dateTicks(lo, hi, target float64) []dateTick {
+	steps := []struct {
+		duration time.Duration
+		months   int
+		format   string
+	}{
+		{time.Second, 0, "15:04:05"},
+		{5 * time.Second, 0, "15:04:05"},
+		{15 * time.Second, 0, "15:04:05"},
+		{time.Minute, 0, "15:04"},
+		{5 * time.Minute, 0, "15:04"},
+		{15 * time.Minute, 0, "15:04"},
+		{time.Hour, 0, "15:04"},
+		{3 * time.Hour, 0, "Jan 2 15:04"},
+		{6 * time.Hour, 0, "Jan 2 15:04"},
+		{12 * time.Hour, 0, "Jan 2 15:04"},
+		{24 * time.Hour, 0, "Jan 2"},
+		{2 * 24 * time.Hour, 0, "Jan 2"},
+		{7 * 24 * time.Hour, 0, "Jan 2"},
+		{14 * 24 * time.Hour, 0, "Jan 2"},
+		{0, 1, "Jan 2006"},
+		{0, 3, "Jan 2006"},
+		{0, 6, "Jan 2006"},
+		{0, 12, "2006"},
+		{0, 24, "2006"},
+		{0, 60, "2006"},
+		{0, 120, "2006"},
+		{0, 600, "2006"},
+		{0, 1200, "2006"},
+	}
+
+	// The span is in float64 milliseconds, as a time.Duration overflows for
+	// ranges over about 292 years
+	span := hi - lo
+	start := time.UnixMilli(int64(lo)).UTC()
+	end := time.UnixMilli(int64(hi)).UTC()
+
+	for i, step := range steps {
+		size := float64(step.duration / time.Millisecond)
+		if step.months > 0 {
+			size = float64(step.months) * 30 * 24 * float64(time.Hour/time.Millisecond)
+		}
+		if span/size > target && i < len(steps)-1 {
+			continue
+		}
+
+		var ticks []dateTick
+		if step.months > 0 {
+			t := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+			for t.Before(start) || !monthAligned(t, step.months) {
+				t = t.AddDate(0, 1, 0)
+			}
+			for n := 0; !t.After(end) && n < maxTicks; t, n = t.AddDate(0, step.months, 0), n+1 {
+				ticks = append(ticks, dateTick{float64(t.UnixMilli()), t.Format(step.format)})
+			}
+		} else {
+			t := start.Truncate(step.duration)
+			if t.Before(start) {
+				t = t.Add(step.duration)
+			}
+			for n := 0; !t.After(end) && n < maxTicks; t, n = t.Add(step.duration), n+1 {
+				ticks = append(ticks, dateTick{float64(t.UnixMilli()), t.Format(step.format)})
+			}
+		}
+		return ticks
+	}
+	return nil
+}
+
+// monthAligned reports whether the first day of a month starts a tick step of
+// the given number of months. Steps of a year or more start in January of a
+// year divisible by the step in years.
+func monthAligned(t time.Time, months int) bool {
+	if months <= 12 {
+		return (int(t.Month())-1)%months == 0
+	}
+	return t.Month() == time.January && t.Year()%(months/12) == 0
+}
+
+// dateLayouts are the date formats recognized in string data
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02 15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	time.RFC3339,
+	time.RFC3339Nano,
+	"2006-01",
+}
+
+// parseDate parses a date string in one of the formats plotly.js accepts
+func parseDate(s string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// categoryKey returns the category name of a raw value
+func categoryKey(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
+
+// toValues returns the elements of an array value, or nil for other values
+func toValues(v interface{}) []interface{} {
+	if v == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil
+	}
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		values[i] = rv.Index(i).Interface()
+	}
+	return values
+}
+
+// toNumber converts a numeric value, or a string holding a number, to float64
+func toNumber(v interface{}) (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+	switch n := v.(type) {
+	case float64:
+		return n, !math.IsNaN(n)
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		return f, err == nil
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), !math.IsNaN(rv.Float())
+	}
+	return 0, false
+}
+
+// toNumbers converts an array value to numbers, using NaN for missing values
+func toNumbers(v interface{}) []float64 {
+	values := toValues(v)
+	numbers := make([]float64, len(values))
+	for i, value := range values {
+		n, ok := toNumber(value)
+		if !ok {
+			n = math.NaN()
+		}
+		numbers[i] = n
+	}
+	return numbers
+}
+
+// valueAt returns element i of an array value, or the value itself when it is
+// not an array
+func valueAt(v interface{}, i int) interface{} {
+	if values := toValues(v); values != nil {
+		if i < len(values) {
+			return values[i]
+		}
+		return nil
+	}
+	return v
+}
+
+// colorAt returns the color for element i of a color value, or fallback
+func colorAt(v interface{}, i int, fallback string) string {
+	if color, ok := valueAt(v, i).(string); ok && color != "" {
+		return color
+	}
+	return fallback
+}
+
+// numberAt returns the number for element i of a number value, or fallback
+func numberAt(v interface{}, i int, fallback float64) float64 {
+	if n, ok := toNumber(valueAt(v, i)); ok {
+		return n
+	}
+	return fallback
+}
+
+// stringValue returns v when it is a non-empty string, or fallback
+func stringValue(v interface{}, fallback string) string {
+	if s, ok := v.(string); ok && s != "" {
+		return s
+	}
+	return fallback
+}
+
+// dashArray returns the dash lengths of a plotly dash style for a line width
+func dashArray(dash string, width float64) []float64 {
+	w := math.Max(width, 1)
+	switch dash {
+	case graph_objects.DashDot:
+		return []float64{w, 2 * w}
+	case graph_objects.DashDash:
+		return []float64{4 * w, 4 * w}
+	case graph_objects.DashLongDash:
+		return []float64{8 * w, 4 * w}
+	case graph_objects.DashDashDot:
+		return []float64{4 * w, 2 * w, w, 2 * w}
+	case graph_objects.DashLongDashDot:
+		return []float64{8 * w, 3 * w, w, 3 * w}
+	}
+	return nil
+}
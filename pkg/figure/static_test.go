@@ -0,0 +1,411 @@
+package figure
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+	"github.com/stretchr/testify/assert"
+)
+
+// staticFigure returns a figure with one trace of every statically
+// supported type except ohlc
+func staticFigure() *Figure {
+	fig := New()
+
+	scatter := graph_objects.NewScatter()
+	scatter.Name = "Revenue"
+	scatter.X = []string{"Q1", "Q2", "Q3", "Q4"}
+	scatter.Y = []float64{10, 15, 13, 17}
+	fig.AddTrace(scatter)
+
+	bar := graph_objects.NewBar()
+	bar.Name = "Costs"
+	bar.X = []string{"Q1", "Q2", "Q3", "Q4"}
+	bar.Y = []int{5, 8, 6, 9}
+	fig.AddTrace(bar)
+
+	box := graph_objects.NewBox()
+	box.X = []string{"Q1", "Q1", "Q1", "Q2", "Q2", "Q2"}
+	box.Y = []float64{1, 2, 3, 4, 5, 20}
+	fig.AddTrace(box)
+
+	fig.Layout = &graph_objects.Layout{
+		Title: &graph_objects.Title{Text: "Quarterly <Results>"},
+		XAxis: &graph_objects.Axis{Title: &graph_objects.Title{Text: "Quarter"}},
+		YAxis: &graph_objects.Axis{Title: &graph_objects.Title{Text: "USD"}},
+	}
+	return fig
+}
+
+// svgElements parses an SVG document and counts its elements by name
+func svgElements(t *testing.T, svg string) map[string]int {
+	t.Helper()
+	counts := make(map[string]int)
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if !assert.NoError(t, err, "invalid SVG") {
+			return counts
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			counts[start.Name.Local]++
+		}
+	}
+	return counts
+}
+
+func TestWriteSVG(t *testing.T) {
+	svg, err := staticFigure().ToSVG()
+	assert.NoError(t, err)
+
+	elements := svgElements(t, svg)
+	assert.Equal(t, 1, elements["svg"])
+	assert.Equal(t, 1, elements["polyline"], "scatter line")
+	assert.GreaterOrEqual(t, elements["circle"], 4, "scatter markers")
+	assert.GreaterOrEqual(t, elements["rect"], 3+4+2, "backgrounds, clip path, bars and boxes")
+
+	assert.Contains(t, svg, `width="700" height="450"`)
+	assert.Contains(t, svg, "Quarterly &lt;Results&gt;")
+	assert.Contains(t, svg, ">Quarter</text>")
+	assert.Contains(t, svg, `rotate(-90`)
+	for _, label := range []string{">Q1<", ">Q4<", ">Revenue<", ">Costs<", ">trace 2<"} {
+		assert.Contains(t, svg, label)
+	}
+}
+
+func TestWriteSVGTraceTypes(t *testing.T) {
+	histogram := graph_objects.NewHistogram()
+	histogram.X = []float64{1, 2, 2, 3, 3, 3}
+	histogram.XBins = &graph_objects.Bins{Start: 0, End: 4, Size: 1}
+
+	ohlc := graph_objects.NewOHLC()
+	ohlc.X = []string{"2024-01-01", "2024-01-02", "2024-01-03"}
+	ohlc.Open = []float64{10, 11, 12}
+	ohlc.High = []float64{12, 13, 14}
+	ohlc.Low = []float64{9, 10, 10}
+	ohlc.Close = []float64{11, 10.5, 13}
+
+	candlestick := graph_objects.NewCandlestickFromOHLC(ohlc)
+	candlestick.WhiskerWidth = graph_objects.Float64(0.5)
+
+	tests := []struct {
+		name     string
+		trace    interface{}
+		elements map[string]int
+	}{
+		{
+			name:     "histogram",
+			trace:    histogram,
+			elements: map[string]int{"rect": 3 + 4}, // backgrounds, clip path and bins
+		},
+		{
+			name:     "ohlc",
+			trace:    ohlc,
+			elements: map[string]int{"line": 3 * 3},
+		},
+		{
+			name:     "candlestick",
+			trace:    candlestick,
+			elements: map[string]int{"rect": 3 + 3, "line": 3 * 4},
+		},
+		{
+			name: "scattergl",
+			trace: &graph_objects.Scattergl{Scatter: graph_objects.Scatter{
+				BaseTrace: graph_objects.BaseTrace{Type: "scattergl"},
+				Mode:      string(graph_objects.ModeMarkers),
+				X:         []float64{1, 2, 3},
+				Y:         []float64{4, 5, 6},
+			}},
+			elements: map[string]int{"circle": 3},
+		},
+		{
+			name: "map trace",
+			trace: map[string]interface{}{
+				"type": "scatter",
+				"mode": "markers",
+				"x":    []interface{}{1, 2, 3},
+				"y":    []interface{}{4, 5, 6},
+			},
+			elements: map[string]int{"circle": 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fig := New()
+			fig.AddTrace(tt.trace)
+			fig.Layout = map[string]interface{}{
+				"xaxis": map[string]interface{}{"showgrid": false},
+				"yaxis": map[string]interface{}{"showgrid": false, "zeroline": false},
+			}
+
+			svg, err := fig.ToSVG()
+			assert.NoError(t, err)
+			elements := svgElements(t, svg)
+			for name, count := range tt.elements {
+				assert.Equal(t, count, elements[name], name)
+			}
+		})
+	}
+}
+
+func TestWriteSVGTinyDTick(t *testing.T) {
+	// A valid figure whose tick step would give 5e8 ticks
+	scatter := graph_objects.NewScatter()
+	scatter.X = []float64{0, 5e8}
+	scatter.Y = []float64{1, 2}
+
+	fig := New()
+	fig.AddTrace(scatter)
+	fig.Layout = &graph_objects.Layout{XAxis: &graph_objects.Axis{DTick: 1}}
+	assert.NoError(t, fig.Validate())
+
+	svg, err := fig.ToSVG()
+	assert.NoError(t, err)
+	assert.Contains(t, svg, ">500M<")
+}
+
+func TestWriteSVGMultiCenturyDates(t *testing.T) {
+	// A date range over the ~292 years a time.Duration can hold
+	scatter := graph_objects.NewScatter()
+	scatter.X = []string{"2020-01-01", "2300-01-01"}
+	scatter.Y = []float64{1, 2}
+
+	fig := New()
+	fig.AddTrace(scatter)
+	assert.NoError(t, fig.Validate())
+
+	svg, err := fig.ToSVG()
+	assert.NoError(t, err)
+	assert.Contains(t, svg, ">2050<")
+	assert.Contains(t, svg, ">2300<")
+}
+
+func TestWriteSVGLayoutSize(t *testing.T) {
+	fig := staticFigure()
+	fig.Layout.(*graph_objects.Layout).Width = 1000
+	fig.Layout.(*graph_objects.Layout).Height = 300
+
+	svg, err := fig.ToSVG()
+	assert.NoError(t, err)
+	assert.Contains(t, svg, `viewBox="0 0 1000 300"`)
+}
+
+func TestWriteSVGUnsupportedTrace(t *testing.T) {
+	fig := New()
+	fig.AddTrace(map[string]interface{}{"type": "pie", "values": []int{1, 2}})
+
+	_, err := fig.ToSVG()
+	assert.EqualError(t, err, "static export does not support pie traces")
+}
+
+func TestWritePNG(t *testing.T) {
+	fig := staticFigure()
+	fig.Layout.(*graph_objects.Layout).PaperBgColor = "rgb(10, 20, 30)"
+	fig.Layout.(*graph_objects.Layout).PlotBgColor = "#fafafa"
+
+	tests := []struct {
+		name                  string
+		width, height         int
+		scale                 float64
+		wantWidth, wantHeight int
+	}{
+		{name: "layout size", wantWidth: 700, wantHeight: 450},
+		{name: "explicit size", width: 400, height: 300, scale: 1, wantWidth: 400, wantHeight: 300},
+		{name: "scaled", width: 400, height: 300, scale: 2, wantWidth: 800, wantHeight: 600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			assert.NoError(t, fig.WritePNG(&buf, tt.width, tt.height, tt.scale))
+
+			img, err := png.Decode(&buf)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantWidth, img.Bounds().Dx())
+			assert.Equal(t, tt.wantHeight, img.Bounds().Dy())
+
+			// Corners show the paper background, the middle of the
+			// plot area shows the data or plot background
+			assert.Equal(t, color.NRGBAModel.Convert(color.NRGBA{10, 20, 30, 255}), color.NRGBAModel.Convert(img.At(1, 1)))
+			assert.NotEqual(t, color.NRGBAModel.Convert(color.NRGBA{10, 20, 30, 255}), color.NRGBAModel.Convert(img.At(tt.wantWidth/2, tt.wantHeight/2)))
+		})
+	}
+}
+
+func TestWritePNGTraceTypes(t *testing.T) {
+	histogram := graph_objects.NewHistogram()
+	histogram.X = []float64{1, 2, 2, 3, 3, 3}
+
+	ohlc := graph_objects.NewOHLC()
+	ohlc.X = []string{"2024-01-01", "2024-01-02"}
+	ohlc.Open = []float64{10, 11}
+	ohlc.High = []float64{12, 13}
+	ohlc.Low = []float64{9, 10}
+	ohlc.Close = []float64{11, 10.5}
+
+	for _, trace := range []interface{}{histogram, ohlc} {
+		fig := New()
+		fig.AddTrace(trace)
+		var buf bytes.Buffer
+		assert.NoError(t, fig.WritePNG(&buf, 200, 150, 1))
+		_, err := png.Decode(&buf)
+		assert.NoError(t, err)
+	}
+}
+
+func TestWritePNGErrors(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Error(t, staticFigure().WritePNG(&buf, -1, 100, 1))
+	assert.Error(t, staticFigure().WritePNG(&buf, 100, 100, -2))
+	assert.Error(t, staticFigure().WritePNG(&buf, 100000, 100000, 1))
+
+	fig := New()
+	fig.AddTrace(map[string]interface{}{"type": "pie"})
+	assert.EqualError(t, fig.WritePNG(&buf, 0, 0, 1), "static export does not support pie traces")
+}
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		input string
+		want  color.NRGBA
+		ok    bool
+	}{
+		{"#1f77b4", color.NRGBA{0x1f, 0x77, 0xb4, 255}, true},
+		{"#fff", color.NRGBA{255, 255, 255, 255}, true},
+		{"#ff000080", color.NRGBA{255, 0, 0, 128}, true},
+		{"rgb(255, 0, 0)", color.NRGBA{255, 0, 0, 255}, true},
+		{"rgba(0, 0, 255, 0.5)", color.NRGBA{0, 0, 255, 128}, true},
+		{"hsl(120, 100%, 50%)", color.NRGBA{0, 255, 0, 255}, true},
+		{"SteelBlue", color.NRGBA{0x46, 0x82, 0xb4, 255}, true},
+		{"transparent", color.NRGBA{}, true},
+		{"", color.NRGBA{}, false},
+		{"#12", color.NRGBA{}, false},
+		{"rgb(1, 2)", color.NRGBA{}, false},
+		{"notacolor", color.NRGBA{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := parseColor(tt.input)
+			assert.Equal(t, tt.ok, ok)
+			if tt.ok {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStaticAxisTicks(t *testing.T) {
+	a := newAxis(nil, []interface{}{0.0, 9.5}, false)
+	a.setPixels(0, 100)
+	var labels []string
+	for _, tick := range a.ticks(5) {
+		labels = append(labels, tick.label)
+	}
+	assert.Equal(t, []string{"0", "2", "4", "6", "8"}, labels)
+
+	a = newAxis(&graph_objects.Axis{Range: []interface{}{0, 50000}}, nil, false)
+	labels = nil
+	for _, tick := range a.ticks(5) {
+		labels = append(labels, tick.label)
+	}
+	assert.Equal(t, []string{"0", "10k", "20k", "30k", "40k", "50k"}, labels)
+
+	// A tick step that is tiny for the range falls back to the computed step
+	a = newAxis(&graph_objects.Axis{DTick: 1}, []interface{}{0.0, 5e8}, false)
+	a.setPixels(0, 100)
+	assert.LessOrEqual(t, len(a.ticks(5)), 10)
+
+	// A range that is not finite has no ticks
+	a = newAxis(nil, []interface{}{-math.MaxFloat64, math.MaxFloat64}, false)
+	a.setPixels(0, 100)
+	assert.Empty(t, a.ticks(5))
+
+	// Date ranges of any length have a bounded number of ticks
+	lo := float64(time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+	hi := float64(time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+	assert.LessOrEqual(t, len(dateTicks(lo, hi, 5)), maxTicks)
+	labels = nil
+	for _, tick := range dateTicks(lo, lo+300*365.25*24*3600*1000, 5) {
+		labels = append(labels, tick.label)
+	}
+	assert.Equal(t, []string{"1000", "1100", "1200", "1300"}, labels)
+
+	a = newAxis(nil, []interface{}{"b", "a", "b"}, false)
+	assert.Equal(t, axisCategory, a.kind)
+	assert.Equal(t, []string{"b", "a"}, a.categories)
+
+	a = newAxis(nil, []interface{}{"2024-01-01", "2024-02-01"}, false)
+	assert.Equal(t, axisDate, a.kind)
+
+	// Widening only applies to autoranged axes
+	a = newAxis(nil, []interface{}{1.0, 2.0, 3.0}, false)
+	min, max := a.min, a.max
+	a.widen(0.5 * a.spacing([]interface{}{1.0, 2.0, 3.0}))
+	assert.Equal(t, []float64{min - 0.5, max + 0.5}, []float64{a.min, a.max})
+
+	a = newAxis(&graph_objects.Axis{Range: []interface{}{0, 4}}, []interface{}{1.0, 2.0, 3.0}, false)
+	a.widen(0.5)
+	assert.Equal(t, []float64{0, 4}, []float64{a.min, a.max})
+}
+
+func TestStaticFormatting(t *testing.T) {
+	tests := []struct {
+		value  float64
+		format string
+		want   string
+	}{
+		{1234.5, ".2f", "1234.50"},
+		{1234.5, ",.1f", "1,234.5"},
+		{0.25, ".0%", "25%"},
+	}
+	for _, tt := range tests {
+		got, ok := formatD3(tt.value, tt.format)
+		assert.True(t, ok)
+		assert.Equal(t, tt.want, got)
+	}
+
+	assert.Equal(t, "0.5", formatNumber(0.5, 0.1))
+	assert.Equal(t, "2.5k", formatNumber(2500, 2500))
+	assert.Equal(t, "3", formatNumber(3, 1))
+}
+
+func TestStaticBoxStats(t *testing.T) {
+	stats := computeBoxStats("a", []float64{1, 2, 3, 4, 5, 20}, "")
+	assert.Equal(t, 3.5, stats.median)
+	assert.Equal(t, 2.25, stats.q1)
+	assert.Equal(t, 4.75, stats.q3)
+	assert.Equal(t, 1.0, stats.lowerFence)
+	assert.Equal(t, 5.0, stats.upperFence)
+	assert.Equal(t, []float64{20}, stats.outliers)
+
+	stats = computeBoxStats("a", []float64{1, 2, 3, 4, 5}, string(graph_objects.QuartileExclusive))
+	assert.Equal(t, 1.5, stats.q1)
+	assert.Equal(t, 4.5, stats.q3)
+}
+
+func TestStaticHistogramBins(t *testing.T) {
+	samples := []interface{}{1.0, 2.0, 2.0, 3.0, 3.0, 3.0}
+	positions, values, size := binHistogram(samples, nil, 0, &graph_objects.Bins{Start: 0.5, End: 3.5, Size: 1}, "")
+	assert.Equal(t, 1.0, size)
+	assert.Equal(t, []interface{}{1.0, 2.0, 3.0}, positions)
+	assert.Equal(t, []float64{1, 2, 3}, values)
+
+	positions, values, _ = binHistogram([]interface{}{"a", "b", "a"}, nil, 0, nil, "")
+	assert.Equal(t, []interface{}{"a", "b"}, positions)
+	assert.Equal(t, []float64{2, 1}, values)
+
+	assert.Equal(t, []float64{50, 50}, normalizeHistogram([]float64{1, 1}, string(graph_objects.NormalizationPercent), 1))
+}
@@ -0,0 +1,1345 @@
+package figure
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+)
+
+// Default trace styles used by static export, matching the plotly.js defaults
+const (
+	defaultLineWidth      = 2
+	defaultMarkerSize     = 6
+	defaultIncreasing     = "#3D9970"
+	defaultDecreasing     = "#FF4136"
+	defaultBarGap         = 0.2
+	defaultBoxGap         = 0.3
+	defaultBoxGroupGap    = 0.3
+	defaultOHLCTickWidth  = 0.3
+	defaultBoxWhiskerSize = 0.5
+)
+
+// buildSeries prepares the traces of a figure for static export
+func buildSeries(traces []graph_objects.Trace, layout *graph_objects.Layout, font textStyle) ([]*series, error) {
+	colorway := defaultColorway
+	if len(layout.Colorway) > 0 {
+		colorway = layout.Colorway
+	}
+
+	var all []*series
+	var bars []*barTrace
+	var boxes []*boxTrace
+	for i, trace := range traces {
+		color := colorway[i%len(colorway)]
+		name := fmt.Sprintf("trace %d", i)
+
+		var s *series
+		switch t := trace.(type) {
+		case *graph_objects.Scatter:
+			if isHidden(t.Visible) {
+				continue
+			}
+			s = scatterSeries(t, color, font)
+			s.apply(t.Name, name, t.Visible, t.ShowLegend)
+		case *graph_objects.Scattergl:
+			if isHidden(t.Visible) {
+				continue
+			}
+			s = scatterSeries(&t.Scatter, color, font)
+			s.apply(t.Name, name, t.Visible, t.ShowLegend)
+		case *graph_objects.Bar:
+			if isHidden(t.Visible) {
+				continue
+			}
+			bar := newBarTrace(t, color, font)
+			bars = append(bars, bar)
+			s = bar.series
+			s.apply(t.Name, name, t.Visible, t.ShowLegend)
+		case *graph_objects.Histogram:
+			if isHidden(t.Visible) {
+				continue
+			}
+			bar := newHistogramTrace(t, color, font)
+			bars = append(bars, bar)
+			s = bar.series
+			s.apply(t.Name, name, t.Visible, t.ShowLegend)
+		case *graph_objects.Box:
+			if isHidden(t.Visible) {
+				continue
+			}
+			if t.Name != "" {
+				name = t.Name
+			}
+			box := newBoxTrace(t, color, name)
+			boxes = append(boxes, box)
+			s = box.series
+			s.apply(t.Name, name, t.Visible, t.ShowLegend)
+		case *graph_objects.OHLC:
+			if isHidden(t.Visible) {
+				continue
+			}
+			s = ohlcSeries(t)
+			s.apply(t.Name, name, t.Visible, t.ShowLegend)
+		case *graph_objects.Candlestick:
+			if isHidden(t.Visible) {
+				continue
+			}
+			s = candlestickSeries(t)
+			s.apply(t.Name, name, t.Visible, t.ShowLegend)
+		default:
+			return nil, fmt.Errorf("static export does not support %s traces", trace.TraceType())
+		}
+		all = append(all, s)
+	}
+
+	arrangeBars(bars, layout)
+	arrangeBoxes(boxes, layout)
+	return all, nil
+}
+
+// apply sets the legend properties common to all traces
+func (s *series) apply(name, fallback string, visible interface{}, showLegend *bool) {
+	s.name = name
+	if s.name == "" {
+		s.name = fallback
+	}
+	s.hidden = visible == "legendonly"
+	s.showLegend = showLegend == nil || *showLegend
+}
+
+// isHidden reports whether a visible attribute hides a trace entirely
+func isHidden(visible interface{}) bool {
+	v, ok := visible.(bool)
+	return ok && !v
+}
+
+// traceOpacity returns the opacity of a trace, defaulting to opaque
+func traceOpacity(opacity *float64) float64 {
+	if opacity == nil {
+		return 1
+	}
+	return *opacity
+}
+
+// indexValues returns the values 0 to n-1, used for missing coordinates
+func indexValues(n int) []interface{} {
+	values := make([]interface{}, n)
+	for i := range values {
+		values[i] = float64(i)
+	}
+	return values
+}
+
+// coordinates returns the x and y values of a trace, filling a missing
+// coordinate with point indices the way plotly.js does
+func coordinates(x, y interface{}) ([]interface{}, []interface{}) {
+	xs, ys := toValues(x), toValues(y)
+	if xs == nil {
+		xs = indexValues(len(ys))
+	}
+	if ys == nil {
+		ys = indexValues(len(xs))
+	}
+	n := len(xs)
+	if len(ys) < n {
+		n = len(ys)
+	}
+	return xs[:n], ys[:n]
+}
+
+// orient returns a canvas point from a position and value coordinate
+func orient(horizontal bool, p, v float64) point {
+	if horizontal {
+		return point{X: v, Y: p}
+	}
+	return point{X: p, Y: v}
+}
+
+// rectBetween returns the rectangle spanned by two points
+func rectBetween(a, b point) (x, y, w, h float64) {
+	return math.Min(a.X, b.X), math.Min(a.Y, b.Y), math.Abs(b.X - a.X), math.Abs(b.Y - a.Y)
+}
+
+// numberPixel converts a number in data units to canvas coordinates,
+// clamping non-positive values to the bottom of log axes
+func (a *axis) numberPixel(v float64) float64 {
+	if a.kind == axisLog {
+		if v <= 0 {
+			return a.pixel(math.Min(a.min, a.max))
+		}
+		return a.pixel(math.Log10(v))
+	}
+	return a.pixel(v)
+}
+
+// spacing returns the smallest distance between distinct positions in axis
+// units, or 1 when there are fewer than two
+func (a *axis) spacing(positions []interface{}) float64 {
+	var values []float64
+	for _, p := range positions {
+		if v, ok := a.value(p); ok {
+			values = append(values, v)
+		}
+	}
+	sort.Float64s(values)
+	min := math.Inf(1)
+	for i := 1; i < len(values); i++ {
+		if d := values[i] - values[i-1]; d > 0 && d < min {
+			min = d
+		}
+	}
+	if math.IsInf(min, 1) {
+		return 1
+	}
+	return min
+}
+
+// drawMarker draws a marker symbol of the given diameter
+func drawMarker(c canvas, x, y, size float64, symbol string, s drawStyle) {
+	r := size / 2
+	switch strings.TrimSuffix(symbol, "-open") {
+	case "square":
+		c.Rect(x-r, y-r, size, size, s)
+	case "diamond":
+		c.Polygon([]point{{x, y - r}, {x + r, y}, {x, y + r}, {x - r, y}}, s)
+	case "triangle-up":
+		c.Polygon([]point{{x, y - r}, {x + r, y + r}, {x - r, y + r}}, s)
+	case "triangle-down":
+		c.Polygon([]point{{x - r, y - r}, {x + r, y - r}, {x, y + r}}, s)
+	default:
+		c.Circle(x, y, r, s)
+	}
+}
+
+// scatterSeries prepares a scatter trace
+func scatterSeries(t *graph_objects.Scatter, color string, font textStyle) *series {
+	xs, ys := coordinates(t.X, t.Y)
+	texts := toValues(t.Text)
+
+	mode := t.Mode
+	if mode == "" {
+		// plotly.js draws markers only for short traces
+		if len(xs) < 20 {
+			mode = string(graph_objects.ModeLinesMarkers)
+		} else {
+			mode = string(graph_objects.ModeLines)
+		}
+	}
+	showLines := strings.Contains(mode, "lines")
+	showMarkers := strings.Contains(mode, "markers")
+	showText := strings.Contains(mode, "text")
+
+	lineWidth, dash, shape := float64(defaultLineWidth), "", ""
+	if t.Line != nil {
+		color = stringValue(t.Line.Color, color)
+		if t.Line.Width > 0 {
+			lineWidth = t.Line.Width
+		}
+		dash, shape = t.Line.Dash, t.Line.Shape
+	}
+	marker := t.Marker
+	if marker == nil {
+		marker = &graph_objects.ScatterMarker{}
+	}
+	opacity := traceOpacity(t.Opacity)
+
+	markerStyle := func(i int) drawStyle {
+		s := fillStyle(colorAt(marker.Color, i, color), opacity)
+		if marker.Line != nil {
+			s.Stroke = colorAt(marker.Line.Color, i, "")
+			s.StrokeWidth = numberAt(marker.Line.Width, i, 0)
+			s.Opacity = opacity
+		}
+		if symbol, _ := valueAt(marker.Symbol, i).(string); strings.HasSuffix(symbol, "-open") {
+			s.Stroke, s.StrokeWidth, s.Opacity = s.Fill, math.Max(s.StrokeWidth, 1), opacity
+			s.Fill = ""
+		}
+		return s
+	}
+	lineStyle := drawStyle{Stroke: color, StrokeWidth: lineWidth, Opacity: opacity, Dash: dash}
+
+	s := &series{color: color, xs: xs, ys: ys}
+	s.draw = func(c canvas, x, y *axis) {
+		var points []point
+		var current []point
+		flush := func() {
+			if showLines && len(current) > 1 {
+				c.Polyline(lineShape(current, shape), lineStyle)
+			}
+			current = nil
+		}
+		for i := range xs {
+			px, okX := x.position(xs[i])
+			py, okY := y.position(ys[i])
+			if !okX || !okY {
+				points = append(points, point{X: math.NaN(), Y: math.NaN()})
+				flush()
+				continue
+			}
+			points = append(points, point{X: px, Y: py})
+			current = append(current, point{X: px, Y: py})
+		}
+		flush()
+
+		for i, p := range points {
+			if math.IsNaN(p.X) {
+				continue
+			}
+			if showMarkers {
+				symbol, _ := valueAt(marker.Symbol, i).(string)
+				drawMarker(c, p.X, p.Y, numberAt(marker.Size, i, defaultMarkerSize), symbol, markerStyle(i))
+			}
+			if showText && i < len(texts) && texts[i] != nil {
+				label := font
+				y := p.Y
+				switch {
+				case strings.HasPrefix(t.TextPosition, "top"):
+					y -= font.Size
+				case strings.HasPrefix(t.TextPosition, "bottom"):
+					y += font.Size
+				}
+				switch {
+				case strings.HasSuffix(t.TextPosition, "left"):
+					label.Anchor = "end"
+				case strings.HasSuffix(t.TextPosition, "right"):
+					label.Anchor = "start"
+				}
+				c.Text(p.X, y, fmt.Sprint(texts[i]), label)
+			}
+		}
+	}
+	s.swatch = func(c canvas, x, y float64) {
+		if showLines {
+			c.Line(x-15, y, x+15, y, lineStyle)
+		}
+		if showMarkers {
+			symbol, _ := valueAt(marker.Symbol, 0).(string)
+			drawMarker(c, x, y, math.Min(numberAt(marker.Size, 0, defaultMarkerSize), 12), symbol, markerStyle(0))
+		}
+		if !showLines && !showMarkers {
+			c.Text(x, y, "Aa", font)
+		}
+	}
+	return s
+}
+
+// lineShape converts a polyline to the step shapes of plotly lines
+func lineShape(points []point, shape string) []point {
+	switch shape {
+	case "hv", "vh", "hvh", "vhv":
+	default:
+		return points
+	}
+
+	shaped := []point{points[0]}
+	for i := 1; i < len(points); i++ {
+		a, b := points[i-1], points[i]
+		switch shape {
+		case "hv":
+			shaped = append(shaped, point{X: b.X, Y: a.Y})
+		case "vh":
+			shaped = append(shaped, point{X: a.X, Y: b.Y})
+		case "hvh":
+			mid := (a.X + b.X) / 2
+			shaped = append(shaped, point{X: mid, Y: a.Y}, point{X: mid, Y: b.Y})
+		case "vhv":
+			mid := (a.Y + b.Y) / 2
+			shaped = append(shaped, point{X: a.X, Y: mid}, point{X: b.X, Y: mid})
+		}
+		shaped = append(shaped, b)
+	}
+	return shaped
+}
+
+// barTrace is a bar or histogram trace prepared for static export. Bars of
+// all traces are arranged together according to the layout barmode.
+type barTrace struct {
+	series     *series
+	horizontal bool
+	histogram  bool
+
+	positions []interface{}
+	values    []float64
+	bases     []interface{} // explicit bases, if any
+	widths    interface{}   // explicit widths, if any
+	binSize   float64       // bin width of numeric histograms
+
+	colors    func(i int) string
+	opacity   float64
+	lineColor interface{}
+	lineWidth interface{}
+	texts     []interface{}
+	textPos   string
+	font      textStyle
+
+	bars []barRect
+}
+
+// barRect is an arranged bar in data units
+type barRect struct {
+	index  int
+	pos    interface{}
+	offset float64 // of the bar center from the position
+	width  float64
+	base   float64
+	value  float64
+}
+
+// newBarTrace prepares a bar trace
+func newBarTrace(t *graph_objects.Bar, color string, font textStyle) *barTrace {
+	b := &barTrace{
+		horizontal: t.Orientation == string(graph_objects.OrientationHorizontal),
+		opacity:    traceOpacity(t.Opacity),
+		widths:     t.Width,
+		texts:      toValues(t.Text),
+		textPos:    t.TextPosition,
+		font:       font,
+	}
+
+	xs, ys := coordinates(t.X, t.Y)
+	values := ys
+	b.positions = xs
+	if b.horizontal {
+		b.positions, values = ys, xs
+	}
+	b.values = toNumbers(values)
+	if bases := toValues(t.Base); bases != nil {
+		b.bases = bases
+	} else if t.Base != nil {
+		b.bases = []interface{}{t.Base}
+	}
+
+	var markerColor interface{}
+	if t.Marker != nil {
+		markerColor = t.Marker.Color
+		if opacity, ok := toNumber(t.Marker.Opacity); ok {
+			b.opacity *= opacity
+		}
+		if t.Marker.Line != nil {
+			b.lineColor, b.lineWidth = t.Marker.Line.Color, t.Marker.Line.Width
+		}
+	}
+	b.colors = func(i int) string { return colorAt(markerColor, i, color) }
+	b.series = &series{color: stringValue(markerColor, color)}
+	return b
+}
+
+// newHistogramTrace bins a histogram trace into bars
+func newHistogramTrace(t *graph_objects.Histogram, color string, font textStyle) *barTrace {
+	b := &barTrace{
+		histogram: true,
+		opacity:   1,
+		texts:     toValues(t.Text),
+		font:      font,
+	}
+
+	samples, others := toValues(t.X), toValues(t.Y)
+	nbins, bins := t.NBinsX, t.XBins
+	if t.Orientation == string(graph_objects.HistogramOrientationHorizontal) || (samples == nil && others != nil) {
+		b.horizontal = true
+		samples, others = others, samples
+		nbins, bins = t.NBinsY, t.YBins
+	}
+
+	b.positions, b.values, b.binSize = binHistogram(samples, others, nbins, bins, t.HistFunc)
+	b.values = normalizeHistogram(b.values, t.HistNorm, b.binSize)
+	if t.CumulativeX != nil && t.CumulativeX.Enabled {
+		b.values = cumulate(b.values, t.CumulativeX.Direction == "decreasing")
+	}
+
+	var markerColor interface{}
+	if t.Opacity > 0 {
+		b.opacity = t.Opacity
+	}
+	if t.Marker != nil {
+		markerColor = t.Marker.Color
+		if t.Marker.Opacity > 0 {
+			b.opacity *= t.Marker.Opacity
+		}
+		if t.Marker.Line != nil {
+			b.lineColor, b.lineWidth = t.Marker.Line.Color, t.Marker.Line.Width
+		}
+	}
+	b.colors = func(i int) string { return colorAt(markerColor, i, color) }
+	b.series = &series{color: stringValue(markerColor, color)}
+	return b
+}
+
+// binHistogram bins the samples of a histogram. Numeric samples are binned
+// into equal-width bins and other samples are counted per category. The
+// returned positions are bin centers or categories.
+func binHistogram(samples, others []interface{}, nbins int, bins *graph_objects.Bins, histFunc string) ([]interface{}, []float64, float64) {
+	numbers := make([]float64, 0, len(samples))
+	numeric := true
+	for _, v := range samples {
+		if v == nil {
+			continue
+		}
+		n, ok := toNumber(v)
+		if !ok {
+			numeric = false
+			break
+		}
+		numbers = append(numbers, n)
+	}
+
+	// Aggregate the other coordinate per bin, or count samples
+	type bin struct {
+		count, sum, min, max float64
+	}
+	aggregate := func(b *bin, i int) {
+		other := 1.0
+		if histFunc != "" && histFunc != string(graph_objects.HistogramFunctionCount) && i < len(others) {
+			n, ok := toNumber(others[i])
+			if !ok {
+				return
+			}
+			other = n
+		}
+		if b.count == 0 {
+			b.min, b.max = other, other
+		}
+		b.count++
+		b.sum += other
+		b.min = math.Min(b.min, other)
+		b.max = math.Max(b.max, other)
+	}
+	result := func(b bin) float64 {
+		switch histFunc {
+		case string(graph_objects.HistogramFunctionSum):
+			return b.sum
+		case string(graph_objects.HistogramFunctionAvg):
+			if b.count == 0 {
+				return 0
+			}
+			return b.sum / b.count
+		case string(graph_objects.HistogramFunctionMin):
+			return b.min
+		case string(graph_objects.HistogramFunctionMax):
+			return b.max
+		}
+		return b.count
+	}
+
+	if !numeric || len(numbers) == 0 {
+		index := make(map[string]int)
+		var positions []interface{}
+		var counts []bin
+		for i, v := range samples {
+			if v == nil {
+				continue
+			}
+			key := categoryKey(v)
+			if _, ok := index[key]; !ok {
+				index[key] = len(positions)
+				positions = append(positions, key)
+				counts = append(counts, bin{})
+			}
+			aggregate(&counts[index[key]], i)
+		}
+		values := make([]float64, len(counts))
+		for i, c := range counts {
+			values[i] = result(c)
+		}
+		return positions, values, 1
+	}
+
+	lo, hi := numbers[0], numbers[0]
+	for _, n := range numbers {
+		lo, hi = math.Min(lo, n), math.Max(hi, n)
+	}
+
+	var start, end, size float64
+	var count int
+	if bins != nil && bins.Size > 0 {
+		start, end, size = bins.Start, bins.End, bins.Size
+		if end <= start {
+			end = hi
+		}
+		count = int(math.Ceil((end - start) / size))
+	} else {
+		if nbins <= 0 {
+			// Sturges' rule
+			nbins = int(math.Ceil(math.Log2(float64(len(numbers))))) + 1
+		}
+		size = niceStep((hi - lo) / float64(nbins))
+		if hi == lo {
+			size = 1
+		}
+		start = math.Floor(lo/size) * size
+		end = hi
+		count = int(math.Floor((end-start)/size)) + 1
+	}
+	if count < 1 {
+		count = 1
+	}
+	if count > 10000 {
+		count = 10000
+	}
+	counts := make([]bin, count)
+	for i, v := range samples {
+		n, ok := toNumber(v)
+		if !ok || n < start || n > end {
+			continue
+		}
+		k := int(math.Floor((n - start) / size))
+		if k >= count {
+			k = count - 1
+		}
+		aggregate(&counts[k], i)
+	}
+
+	positions := make([]interface{}, count)
+	values := make([]float64, count)
+	for i := range counts {
+		positions[i] = start + (float64(i)+0.5)*size
+		values[i] = result(counts[i])
+	}
+	return positions, values, size
+}
+
+// normalizeHistogram applies a histnorm to binned values
+func normalizeHistogram(values []float64, histNorm string, size float64) []float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	if total == 0 {
+		return values
+	}
+
+	normalized := make([]float64, len(values))
+	for i, v := range values {
+		switch histNorm {
+		case string(graph_objects.NormalizationPercent):
+			v = 100 * v / total
+		case string(graph_objects.NormalizationProbability):
+			v = v / total
+		case string(graph_objects.NormalizationDensity):
+			v = v / size
+		case string(graph_objects.NormalizationProbDensity):
+			v = v / (total * size)
+		}
+		normalized[i] = v
+	}
+	return normalized
+}
+
+// cumulate returns the running sums of binned values
+func cumulate(values []float64, decreasing bool) []float64 {
+	sums := make([]float64, len(values))
+	total := 0.0
+	for i := range values {
+		k := i
+		if decreasing {
+			k = len(values) - 1 - i
+		}
+		total += values[k]
+		sums[k] = total
+	}
+	return sums
+}
+
+// arrangeBars positions the bars of all bar-like traces according to the
+// barmode, the way plotly.js groups, stacks and overlays them
+func arrangeBars(traces []*barTrace, layout *graph_objects.Layout) {
+	if len(traces) == 0 {
+		return
+	}
+
+	mode := layout.BarMode
+	if mode == "" {
+		mode = string(graph_objects.BarModeGroup)
+	}
+
+	allHistograms := true
+	for _, t := range traces {
+		allHistograms = allHistograms && t.histogram
+	}
+	gap := defaultBarGap
+	if allHistograms {
+		gap = 0
+	}
+	if layout.BarGap != nil {
+		gap = *layout.BarGap
+	}
+	groupGap := 0.0
+	if layout.BarGroupGap != nil {
+		groupGap = *layout.BarGroupGap
+	}
+
+	for _, horizontal := range []bool{false, true} {
+		var group []*barTrace
+		for _, t := range traces {
+			if t.horizontal == horizontal {
+				group = append(group, t)
+			}
+		}
+		if len(group) == 0 {
+			continue
+		}
+
+		spacing := barSpacing(group)
+		slot := spacing * (1 - gap)
+		stacks := make(map[string][2]float64)
+
+		for k, t := range group {
+			width, offset := slot, 0.0
+			if mode == string(graph_objects.BarModeGroup) {
+				width = slot / float64(len(group))
+				offset = -slot/2 + (float64(k)+0.5)*width
+			}
+
+			t.bars = nil
+			var values []interface{}
+			var edges []interface{}
+			for i, pos := range t.positions {
+				if pos == nil || i >= len(t.values) || math.IsNaN(t.values[i]) {
+					continue
+				}
+				value := t.values[i]
+				bar := barRect{
+					index:  i,
+					pos:    pos,
+					offset: offset,
+					width:  width * (1 - groupGap),
+					value:  value,
+				}
+				if w, ok := toNumber(valueAt(t.widths, i)); ok {
+					bar.width = w
+				}
+
+				key := categoryKey(pos)
+				switch mode {
+				case string(graph_objects.BarModeStack), string(graph_objects.BarModeRelative):
+					stack := stacks[key]
+					side := 0
+					if mode == string(graph_objects.BarModeRelative) && value < 0 {
+						side = 1
+					}
+					bar.base = stack[side]
+					stack[side] += value
+					stacks[key] = stack
+				}
+				if t.bases != nil {
+					if base, ok := toNumber(valueAt(t.bases, i%len(t.bases))); ok {
+						bar.base = base
+					}
+				}
+
+				t.bars = append(t.bars, bar)
+				values = append(values, bar.base, bar.base+bar.value)
+				if n, ok := toNumber(pos); ok {
+					edges = append(edges, n+offset-bar.width/2, n+offset+bar.width/2)
+				} else {
+					edges = append(edges, pos)
+				}
+			}
+
+			if horizontal {
+				t.series.xs, t.series.ys, t.series.xZero = values, edges, true
+			} else {
+				t.series.xs, t.series.ys, t.series.yZero = edges, values, true
+			}
+			t.setDraw()
+		}
+	}
+}
+
+// barSpacing returns the distance between neighboring bar positions of
+// traces sharing a position axis
+func barSpacing(traces []*barTrace) float64 {
+	var numbers []float64
+	for _, t := range traces {
+		if t.histogram && len(t.positions) > 0 {
+			if _, ok := t.positions[0].(float64); ok {
+				return t.binSize
+			}
+		}
+		for _, p := range t.positions {
+			n, ok := toNumber(p)
+			if !ok {
+				return 1
+			}
+			numbers = append(numbers, n)
+		}
+	}
+
+	sort.Float64s(numbers)
+	spacing := math.Inf(1)
+	for i := 1; i < len(numbers); i++ {
+		if d := numbers[i] - numbers[i-1]; d > 0 && d < spacing {
+			spacing = d
+		}
+	}
+	if math.IsInf(spacing, 1) {
+		return 1
+	}
+	return spacing
+}
+
+// setDraw sets the drawing functions of an arranged bar trace
+func (t *barTrace) setDraw() {
+	outline := func(i int, s drawStyle) drawStyle {
+		if color := colorAt(t.lineColor, i, ""); color != "" {
+			s.Stroke = color
+			s.StrokeWidth = numberAt(t.lineWidth, i, 1)
+			s.Opacity = t.opacity
+		}
+		return s
+	}
+
+	t.series.draw = func(c canvas, x, y *axis) {
+		posAxis, valAxis := x, y
+		if t.horizontal {
+			posAxis, valAxis = y, x
+		}
+
+		for _, bar := range t.bars {
+			p, ok := posAxis.value(bar.pos)
+			if !ok {
+				continue
+			}
+			p0 := posAxis.pixel(p + bar.offset - bar.width/2)
+			p1 := posAxis.pixel(p + bar.offset + bar.width/2)
+			v0 := valAxis.numberPixel(bar.base)
+			v1 := valAxis.numberPixel(bar.base + bar.value)
+
+			rx, ry, rw, rh := rectBetween(orient(t.horizontal, p0, v0), orient(t.horizontal, p1, v1))
+			c.Rect(rx, ry, rw, rh, outline(bar.index, fillStyle(t.colors(bar.index), t.opacity)))
+
+			if bar.index >= len(t.texts) || t.texts[bar.index] == nil || t.textPos == graph_objects.TextPositionNone {
+				continue
+			}
+			label := t.font
+			text := fmt.Sprint(t.texts[bar.index])
+			pc := (p0 + p1) / 2
+			offset := t.font.Size
+			if t.textPos != graph_objects.TextPositionOutside {
+				offset = -offset
+			}
+			if bar.value < 0 {
+				offset = -offset
+			}
+			if t.horizontal {
+				label.Anchor = "start"
+				if offset < 0 {
+					label.Anchor = "end"
+				}
+				c.Text(v1+offset/2, pc, text, label)
+			} else {
+				c.Text(pc, v1-offset, text, label)
+			}
+		}
+	}
+	t.series.swatch = func(c canvas, x, y float64) {
+		c.Rect(x-10, y-6, 20, 12, outline(0, fillStyle(t.colors(0), t.opacity)))
+	}
+}
+
+// boxTrace is a box trace prepared for static export
+type boxTrace struct {
+	series     *series
+	horizontal bool
+	boxes      []boxStats
+
+	color, fill  string
+	fillOpacity  float64
+	lineWidth    float64
+	boxPoints    string
+	pointPos     float64
+	jitter       float64
+	mean         string
+	whiskerWidth float64
+	markerSize   float64
+
+	// Arranged in data units
+	width, offset float64
+}
+
+// boxStats are the statistics of the samples of one box
+type boxStats struct {
+	pos                    interface{}
+	q1, median, q3         float64
+	lowerFence, upperFence float64
+	mean, sd               float64
+	samples, outliers      []float64
+}
+
+// newBoxTrace computes the box statistics of a box trace. Samples are grouped
+// by the position coordinate when it is set, otherwise the box is drawn at
+// the trace name.
+func newBoxTrace(t *graph_objects.Box, color, name string) *boxTrace {
+	b := &boxTrace{
+		horizontal:   t.Orientation == string(graph_objects.BoxOrientationHorizontal) || (t.Y == nil && t.X != nil),
+		boxPoints:    t.BoxPoints,
+		pointPos:     t.PointPos,
+		jitter:       t.JitterWidth,
+		whiskerWidth: defaultBoxWhiskerSize,
+		markerSize:   defaultMarkerSize,
+		lineWidth:    defaultLineWidth,
+		fillOpacity:  0.5,
+	}
+	if t.WhiskerWidth > 0 {
+		b.whiskerWidth = t.WhiskerWidth
+	}
+
+	samples, positions := toValues(t.Y), toValues(t.X)
+	if b.horizontal {
+		samples, positions = toValues(t.X), toValues(t.Y)
+	}
+
+	groups := make(map[string][]float64)
+	var order []interface{}
+	for i, v := range samples {
+		n, ok := toNumber(v)
+		if !ok {
+			continue
+		}
+		var pos interface{} = name
+		if positions != nil {
+			if i >= len(positions) || positions[i] == nil {
+				continue
+			}
+			pos = positions[i]
+		}
+		key := categoryKey(pos)
+		if _, exists := groups[key]; !exists {
+			order = append(order, pos)
+		}
+		groups[key] = append(groups[key], n)
+	}
+	for _, pos := range order {
+		b.boxes = append(b.boxes, computeBoxStats(pos, groups[categoryKey(pos)], t.QuartileMethod))
+	}
+
+	switch mean := t.BoxMean.(type) {
+	case bool:
+		if mean {
+			b.mean = string(graph_objects.MeanTrue)
+		}
+	case string:
+		b.mean = mean
+	}
+
+	b.color = color
+	if t.Marker != nil {
+		b.color = stringValue(t.Marker.Color, b.color)
+		if size, ok := toNumber(t.Marker.Size); ok {
+			b.markerSize = size
+		}
+	}
+	if t.Line != nil {
+		b.color = stringValue(t.Line.Color, b.color)
+		if width, ok := toNumber(t.Line.Width); ok {
+			b.lineWidth = width
+		}
+	}
+	b.fill = b.color
+	if fill := stringValue(t.FillColor, ""); fill != "" {
+		b.fill, b.fillOpacity = fill, 1
+	}
+	if t.Opacity != nil {
+		b.fillOpacity *= *t.Opacity
+	}
+
+	var values []interface{}
+	for _, box := range b.boxes {
+		values = append(values, box.lowerFence, box.upperFence)
+		for _, o := range box.outliers {
+			values = append(values, o)
+		}
+	}
+	b.series = &series{color: b.color}
+	if b.horizontal {
+		b.series.xs = values
+	} else {
+		b.series.ys = values
+	}
+	return b
+}
+
+// computeBoxStats computes quartiles, whisker fences and outliers using the
+// plotly.js quartile methods
+func computeBoxStats(pos interface{}, samples []float64, method string) boxStats {
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+	n := len(sorted)
+	stats := boxStats{pos: pos, samples: samples}
+
+	stats.median = quantile(sorted, 0.5)
+	switch method {
+	case string(graph_objects.QuartileExclusive), string(graph_objects.QuartileInclusive):
+		half := n / 2
+		lower, upper := sorted[:half], sorted[n-half:]
+		if n%2 == 1 && method == string(graph_objects.QuartileInclusive) {
+			lower, upper = sorted[:half+1], sorted[half:]
+		}
+		if len(lower) == 0 {
+			lower, upper = sorted, sorted
+		}
+		stats.q1, stats.q3 = quantile(lower, 0.5), quantile(upper, 0.5)
+	default:
+		stats.q1, stats.q3 = quantile(sorted, 0.25), quantile(sorted, 0.75)
+	}
+
+	for _, v := range sorted {
+		stats.mean += v
+	}
+	stats.mean /= float64(n)
+	for _, v := range sorted {
+		stats.sd += (v - stats.mean) * (v - stats.mean)
+	}
+	stats.sd = math.Sqrt(stats.sd / float64(n))
+
+	iqr := stats.q3 - stats.q1
+	stats.lowerFence, stats.upperFence = stats.q1, stats.q3
+	for _, v := range sorted {
+		if v >= stats.q1-1.5*iqr {
+			stats.lowerFence = math.Min(v, stats.q1)
+			break
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		if sorted[i] <= stats.q3+1.5*iqr {
+			stats.upperFence = math.Max(sorted[i], stats.q3)
+			break
+		}
+	}
+	for _, v := range sorted {
+		if v < stats.lowerFence || v > stats.upperFence {
+			stats.outliers = append(stats.outliers, v)
+		}
+	}
+	return stats
+}
+
+// quantile returns the linearly interpolated quantile of sorted values
+func quantile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return math.NaN()
+	}
+	pos := p * float64(len(sorted)-1)
+	lo := int(math.Floor(pos))
+	hi := int(math.Ceil(pos))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(pos-float64(lo))
+}
+
+// arrangeBoxes sizes and offsets the boxes of all box traces according to
+// the boxmode
+func arrangeBoxes(traces []*boxTrace, layout *graph_objects.Layout) {
+	gap, groupGap := defaultBoxGap, defaultBoxGroupGap
+	if layout.BoxGap != nil {
+		gap = *layout.BoxGap
+	}
+	if layout.BoxGroupGap != nil {
+		groupGap = *layout.BoxGroupGap
+	}
+
+	for _, horizontal := range []bool{false, true} {
+		var group []*boxTrace
+		var positions []interface{}
+		for _, t := range traces {
+			if t.horizontal == horizontal {
+				group = append(group, t)
+				for _, box := range t.boxes {
+					positions = append(positions, box.pos)
+				}
+			}
+		}
+
+		spacing := 1.0
+		numeric := len(positions) > 0
+		for _, p := range positions {
+			if _, ok := toNumber(p); !ok {
+				numeric = false
+			}
+		}
+		if numeric {
+			spacing = barSpacing([]*barTrace{{positions: positions}})
+		}
+
+		slot := spacing * (1 - gap)
+		for k, t := range group {
+			t.width, t.offset = slot, 0
+			if layout.BoxMode == string(graph_objects.BoxModeGroup) {
+				t.width = slot / float64(len(group))
+				t.offset = -slot/2 + (float64(k)+0.5)*t.width
+				t.width *= 1 - groupGap
+			}
+
+			var edges []interface{}
+			for _, box := range t.boxes {
+				if n, ok := toNumber(box.pos); ok {
+					edges = append(edges, n+t.offset-t.width/2, n+t.offset+t.width/2)
+				} else {
+					edges = append(edges, box.pos)
+				}
+			}
+			if horizontal {
+				t.series.ys = edges
+			} else {
+				t.series.xs = edges
+			}
+			t.setDraw()
+		}
+	}
+}
+
+// setDraw sets the drawing functions of an arranged box trace
+func (t *boxTrace) setDraw() {
+	line := strokeStyle(t.color, t.lineWidth)
+	body := line
+	body.Fill, body.FillOpacity = t.fill, t.fillOpacity
+
+	t.series.draw = func(c canvas, x, y *axis) {
+		posAxis, valAxis := x, y
+		if t.horizontal {
+			posAxis, valAxis = y, x
+		}
+		pt := func(p, v float64) point { return orient(t.horizontal, p, v) }
+
+		for _, box := range t.boxes {
+			center, ok := posAxis.value(box.pos)
+			if !ok {
+				continue
+			}
+			center += t.offset
+			p0, pc, p1 := posAxis.pixel(center-t.width/2), posAxis.pixel(center), posAxis.pixel(center+t.width/2)
+			half := math.Abs(p1-p0) / 2
+			v := valAxis.numberPixel
+
+			rx, ry, rw, rh := rectBetween(pt(p0, v(box.q1)), pt(p1, v(box.q3)))
+			c.Rect(rx, ry, rw, rh, body)
+			segment := func(a, b point, s drawStyle) { c.Line(a.X, a.Y, b.X, b.Y, s) }
+			segment(pt(p0, v(box.median)), pt(p1, v(box.median)), line)
+
+			// Whiskers
+			w := half * t.whiskerWidth
+			segment(pt(pc, v(box.q1)), pt(pc, v(box.lowerFence)), line)
+			segment(pt(pc, v(box.q3)), pt(pc, v(box.upperFence)), line)
+			segment(pt(pc-w, v(box.lowerFence)), pt(pc+w, v(box.lowerFence)), line)
+			segment(pt(pc-w, v(box.upperFence)), pt(pc+w, v(box.upperFence)), line)
+
+			// Mean and standard deviation
+			if t.mean == string(graph_objects.MeanTrue) || t.mean == string(graph_objects.MeanSD) {
+				dashed := line
+				dashed.Dash = graph_objects.DashDash
+				segment(pt(p0, v(box.mean)), pt(p1, v(box.mean)), dashed)
+			}
+			if t.mean == string(graph_objects.MeanSD) {
+				dashed := line
+				dashed.Dash = graph_objects.DashDash
+				top, mid, bottom := v(box.mean+box.sd), v(box.mean), v(box.mean-box.sd)
+				diamond := []point{pt(pc, top), pt(p1, mid), pt(pc, bottom), pt(p0, mid), pt(pc, top)}
+				c.Polyline(diamond, dashed)
+			}
+
+			// Points
+			points := box.outliers
+			pos := pc
+			switch t.boxPoints {
+			case graph_objects.BoxPointsFalse:
+				points = nil
+			case graph_objects.BoxPointsAll:
+				points = box.samples
+				pointPos := t.pointPos
+				if pointPos == 0 {
+					pointPos = -1.5
+				}
+				pos = pc + pointPos*half
+				if t.horizontal {
+					pos = pc - pointPos*half
+				}
+			}
+			for i, sample := range points {
+				jitter := 0.0
+				if t.boxPoints == graph_objects.BoxPointsAll && t.jitter > 0 {
+					jitter = (jitterFraction(i) - 0.5) * t.jitter * half
+				}
+				p := pt(pos+jitter, v(sample))
+				c.Circle(p.X, p.Y, t.markerSize/2, fillStyle(t.color, 1))
+			}
+		}
+	}
+	t.series.swatch = func(c canvas, x, y float64) {
+		c.Rect(x-10, y-6, 20, 12, body)
+	}
+}
+
+// jitterFraction returns a deterministic pseudo-random fraction for the
+// jitter of point i, so that exports are reproducible
+func jitterFraction(i int) float64 {
+	return math.Mod(math.Abs(math.Sin(float64(i+1)*12.9898)*43758.5453), 1)
+}
+
+// ohlcSeries prepares an OHLC trace
+func ohlcSeries(t *graph_objects.OHLC) *series {
+	xs := toValues(t.X)
+	open, high, low, closing := toNumbers(t.Open), toNumbers(t.High), toNumbers(t.Low), toNumbers(t.Close)
+
+	tickWidth := defaultOHLCTickWidth
+	if t.TickWidth > 0 {
+		tickWidth = t.TickWidth
+	}
+	opacity := 1.0
+	if t.Opacity > 0 {
+		opacity = t.Opacity
+	}
+	base := drawStyle{StrokeWidth: defaultLineWidth, Opacity: opacity}
+	if t.Line != nil {
+		if t.Line.Width > 0 {
+			base.StrokeWidth = t.Line.Width
+		}
+		base.Dash = t.Line.Dash
+	}
+	directionStyle := func(d *graph_objects.OHLCDirection, color string) drawStyle {
+		s := base
+		s.Stroke = color
+		if d != nil {
+			if d.Color != "" {
+				s.Stroke = d.Color
+			}
+			if d.Line != nil {
+				if d.Line.Width > 0 {
+					s.StrokeWidth = d.Line.Width
+				}
+				if d.Line.Dash != "" {
+					s.Dash = d.Line.Dash
+				}
+			}
+		}
+		return s
+	}
+	increasing := directionStyle(t.Increasing, defaultIncreasing)
+	decreasing := directionStyle(t.Decreasing, defaultDecreasing)
+
+	n := len(xs)
+	for _, values := range [][]float64{open, high, low, closing} {
+		if len(values) < n {
+			n = len(values)
+		}
+	}
+
+	s := &series{color: increasing.Stroke, xs: xs[:n], xSpread: tickWidth}
+	for i := 0; i < n; i++ {
+		s.ys = append(s.ys, low[i], high[i])
+	}
+	s.draw = func(c canvas, x, y *axis) {
+		tick := x.length(x.spacing(xs[:n])) * tickWidth
+		for i := 0; i < n; i++ {
+			px, ok := x.position(xs[i])
+			if !ok || math.IsNaN(open[i]+high[i]+low[i]+closing[i]) {
+				continue
+			}
+			style := increasing
+			if closing[i] < open[i] {
+				style = decreasing
+			}
+			c.Line(px, y.numberPixel(low[i]), px, y.numberPixel(high[i]), style)
+			c.Line(px-tick, y.numberPixel(open[i]), px, y.numberPixel(open[i]), style)
+			c.Line(px, y.numberPixel(closing[i]), px+tick, y.numberPixel(closing[i]), style)
+		}
+	}
+	s.swatch = func(c canvas, x, y float64) {
+		c.Line(x-6, y-6, x-6, y+6, increasing)
+		c.Line(x-10, y-2, x-6, y-2, increasing)
+		c.Line(x-6, y+2, x-2, y+2, increasing)
+		c.Line(x+6, y-6, x+6, y+6, decreasing)
+		c.Line(x+2, y+2, x+6, y+2, decreasing)
+		c.Line(x+6, y-2, x+10, y-2, decreasing)
+	}
+	return s
+}
+
+// candlestickSeries prepares a candlestick trace
+func candlestickSeries(t *graph_objects.Candlestick) *series {
+	xs := toValues(t.X)
+	open, high, low, closing := toNumbers(t.Open), toNumbers(t.High), toNumbers(t.Low), toNumbers(t.Close)
+
+	opacity := traceOpacity(t.Opacity)
+	whiskerWidth := 0.0
+	if t.WhiskerWidth != nil {
+		whiskerWidth = *t.WhiskerWidth
+	}
+	lineWidth := float64(defaultLineWidth)
+	if t.Line != nil && t.Line.Width > 0 {
+		lineWidth = t.Line.Width
+	}
+	// Candles are filled with their line color at half opacity by default
+	directionStyle := func(d *graph_objects.CandlestickDirection, color string) drawStyle {
+		s := drawStyle{Stroke: color, StrokeWidth: lineWidth, Opacity: opacity, FillOpacity: 0.5 * opacity}
+		if d != nil && d.Line != nil {
+			if d.Line.Color != "" {
+				s.Stroke = d.Line.Color
+			}
+			if d.Line.Width > 0 {
+				s.StrokeWidth = d.Line.Width
+			}
+		}
+		s.Fill = s.Stroke
+		if d != nil && d.FillColor != "" {
+			s.Fill = d.FillColor
+			s.FillOpacity = opacity
+		}
+		return s
+	}
+	increasing := directionStyle(t.Increasing, defaultIncreasing)
+	decreasing := directionStyle(t.Decreasing, defaultDecreasing)
+
+	n := len(xs)
+	for _, values := range [][]float64{open, high, low, closing} {
+		if len(values) < n {
+			n = len(values)
+		}
+	}
+
+	width := (1 - defaultBoxGap) * (1 - defaultBoxGroupGap)
+	s := &series{color: increasing.Stroke, xs: xs[:n], xSpread: width / 2}
+	for i := 0; i < n; i++ {
+		s.ys = append(s.ys, low[i], high[i])
+	}
+	s.draw = func(c canvas, x, y *axis) {
+		half := x.length(x.spacing(xs[:n])) * width / 2
+		for i := 0; i < n; i++ {
+			px, ok := x.position(xs[i])
+			if !ok || math.IsNaN(open[i]+high[i]+low[i]+closing[i]) {
+				continue
+			}
+			style := increasing
+			if closing[i] < open[i] {
+				style = decreasing
+			}
+			bodyTop := y.numberPixel(math.Max(open[i], closing[i]))
+			bodyBottom := y.numberPixel(math.Min(open[i], closing[i]))
+			top, bottom := math.Min(bodyTop, bodyBottom), math.Max(bodyTop, bodyBottom)
+			c.Rect(px-half, top, 2*half, bottom-top, style)
+
+			whisker := style
+			whisker.Fill = ""
+			highPixel, lowPixel := y.numberPixel(high[i]), y.numberPixel(low[i])
+			c.Line(px, math.Min(highPixel, lowPixel), px, top, whisker)
+			c.Line(px, bottom, px, math.Max(highPixel, lowPixel), whisker)
+			if whiskerWidth > 0 {
+				w := half * whiskerWidth
+				c.Line(px-w, highPixel, px+w, highPixel, whisker)
+				c.Line(px-w, lowPixel, px+w, lowPixel, whisker)
+			}
+		}
+	}
+	s.swatch = func(c canvas, x, y float64) {
+		for _, candle := range []struct {
+			x     float64
+			style drawStyle
+		}{{x - 5, increasing}, {x + 5, decreasing}} {
+			whisker := candle.style
+			whisker.Fill = ""
+			c.Line(candle.x, y-7, candle.x, y+7, whisker)
+			c.Rect(candle.x-3, y-4, 6, 8, candle.style)
+		}
+	}
+	return s
+}
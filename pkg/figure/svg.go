@@ -0,0 +1,143 @@
+package figure
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// WriteSVG renders the figure as a static SVG image. The image is drawn in Go
+// without a browser, so only scatter, bar, histogram, box and ohlc traces
+// are supported.
+func (f *Figure) WriteSVG(w io.Writer) error {
+	ch, err := f.newStaticChart(0, 0)
+	if err != nil {
+		return err
+	}
+
+	c := &svgCanvas{}
+	fmt.Fprintf(&c.buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n",
+		svgNumber(ch.width), svgNumber(ch.height), svgNumber(ch.width), svgNumber(ch.height))
+	ch.draw(c)
+	c.Unclip()
+	c.buf.WriteString("</svg>\n")
+
+	_, err = w.Write(c.buf.Bytes())
+	return err
+}
+
+// ToSVG renders the figure as a static SVG image
+func (f *Figure) ToSVG() (string, error) {
+	var buf bytes.Buffer
+	if err := f.WriteSVG(&buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// svgCanvas draws onto an SVG document
+type svgCanvas struct {
+	buf     bytes.Buffer
+	clips   int
+	clipped bool
+}
+
+func (c *svgCanvas) Rect(x, y, w, h float64, s drawStyle) {
+	fmt.Fprintf(&c.buf, `<rect x="%s" y="%s" width="%s" height="%s"%s/>`+"\n",
+		svgNumber(x), svgNumber(y), svgNumber(w), svgNumber(h), svgStyle(s, true))
+}
+
+func (c *svgCanvas) Line(x1, y1, x2, y2 float64, s drawStyle) {
+	fmt.Fprintf(&c.buf, `<line x1="%s" y1="%s" x2="%s" y2="%s"%s/>`+"\n",
+		svgNumber(x1), svgNumber(y1), svgNumber(x2), svgNumber(y2), svgStyle(s, false))
+}
+
+func (c *svgCanvas) Polyline(points []point, s drawStyle) {
+	fmt.Fprintf(&c.buf, `<polyline points="%s"%s/>`+"\n", svgPoints(points), svgStyle(s, false))
+}
+
+func (c *svgCanvas) Polygon(points []point, s drawStyle) {
+	fmt.Fprintf(&c.buf, `<polygon points="%s"%s/>`+"\n", svgPoints(points), svgStyle(s, true))
+}
+
+func (c *svgCanvas) Circle(cx, cy, r float64, s drawStyle) {
+	fmt.Fprintf(&c.buf, `<circle cx="%s" cy="%s" r="%s"%s/>`+"\n",
+		svgNumber(cx), svgNumber(cy), svgNumber(r), svgStyle(s, true))
+}
+
+func (c *svgCanvas) Text(x, y float64, text string, s textStyle) {
+	anchor := s.Anchor
+	if anchor == "" {
+		anchor = "start"
+	}
+	transform := ""
+	if s.Angle != 0 {
+		transform = fmt.Sprintf(` transform="rotate(%s %s %s)"`, svgNumber(s.Angle), svgNumber(x), svgNumber(y))
+	}
+	fmt.Fprintf(&c.buf, `<text x="%s" y="%s" text-anchor="%s" dominant-baseline="central" font-family="%s" font-size="%s" fill="%s"%s>%s</text>`+"\n",
+		svgNumber(x), svgNumber(y), anchor, html.EscapeString(s.Family), svgNumber(s.Size),
+		html.EscapeString(s.Color), transform, html.EscapeString(text))
+}
+
+func (c *svgCanvas) Clip(x, y, w, h float64) {
+	c.Unclip()
+	c.clips++
+	id := fmt.Sprintf("clip%d", c.clips)
+	fmt.Fprintf(&c.buf, `<clipPath id="%s"><rect x="%s" y="%s" width="%s" height="%s"/></clipPath>`+"\n",
+		id, svgNumber(x), svgNumber(y), svgNumber(w), svgNumber(h))
+	fmt.Fprintf(&c.buf, `<g clip-path="url(#%s)">`+"\n", id)
+	c.clipped = true
+}
+
+func (c *svgCanvas) Unclip() {
+	if c.clipped {
+		c.buf.WriteString("</g>\n")
+		c.clipped = false
+	}
+}
+
+// svgStyle returns the presentation attributes of a style. Shapes that are
+// not closed are never filled.
+func svgStyle(s drawStyle, closed bool) string {
+	var b strings.Builder
+	if closed && s.Fill != "" {
+		fmt.Fprintf(&b, ` fill="%s"`, html.EscapeString(s.Fill))
+		if s.FillOpacity < 1 {
+			fmt.Fprintf(&b, ` fill-opacity="%s"`, svgNumber(s.FillOpacity))
+		}
+	} else {
+		b.WriteString(` fill="none"`)
+	}
+	if s.Stroke != "" && s.StrokeWidth > 0 {
+		fmt.Fprintf(&b, ` stroke="%s" stroke-width="%s"`, html.EscapeString(s.Stroke), svgNumber(s.StrokeWidth))
+		if s.Opacity < 1 {
+			fmt.Fprintf(&b, ` stroke-opacity="%s"`, svgNumber(s.Opacity))
+		}
+		if dashes := dashArray(s.Dash, s.StrokeWidth); dashes != nil {
+			values := make([]string, len(dashes))
+			for i, d := range dashes {
+				values[i] = svgNumber(d)
+			}
+			fmt.Fprintf(&b, ` stroke-dasharray="%s"`, strings.Join(values, ","))
+		}
+	}
+	return b.String()
+}
+
+// svgPoints formats the points attribute of polylines and polygons
+func svgPoints(points []point) string {
+	values := make([]string, len(points))
+	for i, p := range points {
+		values[i] = svgNumber(p.X) + "," + svgNumber(p.Y)
+	}
+	return strings.Join(values, " ")
+}
+
+// svgNumber formats a coordinate with at most two decimals
+func svgNumber(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
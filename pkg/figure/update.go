@@ -0,0 +1,334 @@
+package figure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ekinolik/go-plotly/pkg/graph_objects"
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// matchesSelector reports whether every selector attribute of the trace
+// equals the selector value
+func matchesSelector(trace interface{}, selector map[string]interface{}) bool {
+	for path, want := range selector {
+		got, ok := lookupAttribute(reflect.ValueOf(trace), strings.Split(path, "."))
+		if !ok || !attributeEqual(got, want) {
+			return false
+		}
+	}
+	return true
+}
+
+// applyUpdates returns a copy of the trace with the updates applied. The copy
+// has the same concrete type as the trace.
+func applyUpdates(trace interface{}, updates map[string]interface{}) (interface{}, error) {
+	v := reflect.ValueOf(trace)
+	switch {
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+	case v.Kind() == reflect.Ptr && !v.IsNil() && v.Elem().Kind() == reflect.Struct:
+	default:
+		return nil, fmt.Errorf("unsupported trace type %T", trace)
+	}
+
+	// Create a copy of the trace
+	updated := cloneValue(v)
+	target := updated
+	if target.Kind() == reflect.Ptr {
+		target = target.Elem()
+	}
+
+	// Apply updates
+	for path, value := range updates {
+		if err := setAttribute(target, strings.Split(path, "."), value); err != nil {
+			return nil, fmt.Errorf("error setting %s: %v", path, err)
+		}
+	}
+
+	updatedTrace := updated.Interface()
+	if validator, ok := updatedTrace.(validation.Validator); ok {
+		if err := validator.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return updatedTrace, nil
+}
+
+// lookupAttribute returns the value at the attribute path of a map or struct
+func lookupAttribute(v reflect.Value, path []string) (interface{}, bool) {
+	for _, name := range path {
+		v = indirect(v)
+		switch v.Kind() {
+		case reflect.Map:
+			if v.Type().Key().Kind() != reflect.String {
+				return nil, false
+			}
+			v = v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+			if !v.IsValid() {
+				return nil, false
+			}
+		case reflect.Struct:
+			field, ok := fieldByJSONName(v, name)
+			if !ok {
+				extra, ok := extraField(v)
+				if !ok || extra.IsNil() {
+					return nil, false
+				}
+				field = extra.MapIndex(reflect.ValueOf(name))
+				if !field.IsValid() {
+					return nil, false
+				}
+			}
+			v = field
+		default:
+			return nil, false
+		}
+	}
+
+	v = indirect(v)
+	if !v.IsValid() {
+		return nil, true
+	}
+	return v.Interface(), true
+}
+
+// setAttribute sets the value at the attribute path of a map or an
+// addressable struct, creating intermediate objects as needed
+func setAttribute(v reflect.Value, path []string, value interface{}) error {
+	name := path[0]
+	last := len(path) == 1
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			if !v.CanSet() {
+				return fmt.Errorf("cannot set attribute %q", name)
+			}
+			if v.Kind() == reflect.Ptr {
+				v.Set(reflect.New(v.Type().Elem()))
+			} else {
+				object := reflect.ValueOf(map[string]interface{}{})
+				if !object.Type().AssignableTo(v.Type()) {
+					return fmt.Errorf("cannot create attribute %q as %s", name, v.Type())
+				}
+				v.Set(object)
+			}
+		}
+		if v.Kind() == reflect.Interface && v.Elem().Kind() == reflect.Struct {
+			// Struct values held in an interface are not addressable, so
+			// update a copy and store it back
+			if !v.CanSet() {
+				return fmt.Errorf("cannot set attribute %q", name)
+			}
+			elem := reflect.New(v.Elem().Type()).Elem()
+			elem.Set(v.Elem())
+			if err := setAttribute(elem, path, value); err != nil {
+				return err
+			}
+			v.Set(elem)
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("attribute %q is not an object", name)
+		}
+		if v.IsNil() {
+			if !v.CanSet() {
+				return fmt.Errorf("cannot set attribute %q", name)
+			}
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		key := reflect.ValueOf(name).Convert(v.Type().Key())
+		elem := reflect.New(v.Type().Elem()).Elem()
+		if existing := v.MapIndex(key); existing.IsValid() {
+			elem.Set(existing)
+		}
+		if last {
+			if err := assignValue(elem, value); err != nil {
+				return err
+			}
+		} else if err := setAttribute(elem, path[1:], value); err != nil {
+			return err
+		}
+		v.SetMapIndex(key, elem)
+		return nil
+	case reflect.Struct:
+		if !v.CanAddr() {
+			return fmt.Errorf("cannot set attribute %q", name)
+		}
+		field, ok := fieldByJSONName(v, name)
+		if !ok {
+			// Attributes without a typed field go to the Extra properties
+			extra, ok := extraField(v)
+			if !ok {
+				return fmt.Errorf("unknown attribute %q", name)
+			}
+			return setAttribute(extra, path, value)
+		}
+		if last {
+			return assignValue(field, value)
+		}
+		return setAttribute(field, path[1:], value)
+	default:
+		return fmt.Errorf("attribute %q is not an object", name)
+	}
+}
+
+// assignValue sets dst to value, converting through JSON when the types differ
+func assignValue(dst reflect.Value, value interface{}) error {
+	if !dst.CanSet() {
+		return fmt.Errorf("cannot set %s value", dst.Type())
+	}
+	if value == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	src := reflect.ValueOf(value)
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+	if isNumber(src.Kind()) && isNumber(dst.Kind()) ||
+		src.Kind() == reflect.String && dst.Kind() == reflect.String {
+		dst.Set(src.Convert(dst.Type()))
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	converted := reflect.New(dst.Type())
+	if err := json.Unmarshal(data, converted.Interface()); err != nil {
+		return fmt.Errorf("cannot use %T as %s", value, dst.Type())
+	}
+	dst.Set(converted.Elem())
+	return nil
+}
+
+// fieldByJSONName returns the struct field with the given JSON name. Fields of
+// the outer struct shadow fields of embedded structs.
+func fieldByJSONName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	var embedded []int
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			embedded = append(embedded, i)
+			continue
+		}
+		if graph_objects.JSONFieldName(f) == name {
+			return v.Field(i), true
+		}
+	}
+	for _, i := range embedded {
+		if field, ok := fieldByJSONName(v.Field(i), name); ok {
+			return field, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// extraField returns the Extra properties map of a trace struct, such as
+// the one of graph_objects.BaseTrace
+func extraField(v reflect.Value) (reflect.Value, bool) {
+	field := v.FieldByName("Extra")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(map[string]interface{}{}) {
+		return reflect.Value{}, false
+	}
+	return field, true
+}
+
+// cloneValue returns a deep copy of v
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Type().Elem())
+		out.Elem().Set(cloneValue(v.Elem()))
+		return out
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(cloneValue(v.Elem()))
+		return out
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if out.Field(i).CanSet() {
+				out.Field(i).Set(cloneValue(v.Field(i)))
+			}
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(iter.Key(), cloneValue(iter.Value()))
+		}
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// attributeEqual compares attribute values, treating values with the same
+// JSON encoding (e.g. 1 and 1.0) as equal
+func attributeEqual(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	aData, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bData, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aData, bData)
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -60,17 +60,8 @@ func (b *Bar) Validate() error {
 	}
 
 	// Validate orientation if specified
-	if b.Orientation != "" {
-		validOrientations := map[string]bool{
-			string(OrientationVertical):   true,
-			string(OrientationHorizontal): true,
-		}
-		if !validOrientations[b.Orientation] {
-			return &validation.ValidationError{
-				Field:   "Orientation",
-				Message: fmt.Sprintf("invalid orientation: %s", b.Orientation),
-			}
-		}
+	if err := validateBarOrientation(b.Orientation); err != nil {
+		return err
 	}
 
 	// Validate that X and Y are present
@@ -84,12 +75,30 @@ func (b *Bar) Validate() error {
 	return nil
 }
 
+// validateBarOrientation validates the orientation of bar-like traces
+func validateBarOrientation(orientation string) error {
+	if orientation == "" {
+		return nil
+	}
+	validOrientations := map[string]bool{
+		string(OrientationVertical):   true,
+		string(OrientationHorizontal): true,
+	}
+	if !validOrientations[orientation] {
+		return &validation.ValidationError{
+			Field:   "Orientation",
+			Message: fmt.Sprintf("invalid orientation: %s", orientation),
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaler interface
 func (b *Bar) MarshalJSON() ([]byte, error) {
 	m := make(map[string]interface{})
 
 	// Add base trace fields
-	baseData, err := json.Marshal(b.BaseTrace)
+	baseData, err := json.Marshal(&b.BaseTrace)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,145 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Barpolar represents a bar trace in polar coordinates, drawn as wedges from
+// the center out to radius R at angle Theta, for example the bins of a wind
+// rose
+type Barpolar struct {
+	BaseTrace
+	// Data
+	R         interface{} `json:"r,omitempty"`
+	Theta     interface{} `json:"theta,omitempty"`     // angles, or categories on a category angular axis
+	ThetaUnit string      `json:"thetaunit,omitempty"` // "degrees" or "radians"
+	Width     interface{} `json:"width,omitempty"`     // angular width in ThetaUnit, number or array
+	Base      interface{} `json:"base,omitempty"`      // radius the bars start at, number or array
+	Offset    interface{} `json:"offset,omitempty"`    // angular offset, number or array
+
+	// Styling
+	Marker *BarMarker `json:"marker,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	Subplot     string `json:"subplot,omitempty"` // e.g. "polar" or "polar2"
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// NewBarpolar creates a new polar bar trace
+func NewBarpolar() *Barpolar {
+	return &Barpolar{
+		BaseTrace: BaseTrace{
+			Type: "barpolar",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (b *Barpolar) Validate() error {
+	if err := b.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validatePolarData(b.R, b.Theta); err != nil {
+		return err
+	}
+	if err := validateThetaUnit("ThetaUnit", b.ThetaUnit); err != nil {
+		return err
+	}
+
+	// Validate widths, given for all bars or one per bar
+	if b.Width != nil {
+		widths, ok := toFloat64Slice(b.Width)
+		if !ok {
+			width, isNumber := toFloat64(b.Width)
+			if !isNumber {
+				return &validation.ValidationError{
+					Field:   "Width",
+					Message: "width must be a number or an array of numbers",
+				}
+			}
+			widths = []float64{width}
+		} else if bars, isArray := arrayLength(b.R); isArray && len(widths) != bars {
+			return &validation.ValidationError{
+				Field:   "Width",
+				Message: fmt.Sprintf("%d widths given for %d bars", len(widths), bars),
+			}
+		}
+		for _, w := range widths {
+			if w < 0 {
+				return &validation.ValidationError{
+					Field:   "Width",
+					Message: "width must be non-negative",
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (b *Barpolar) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&b.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type
+	m["type"] = "barpolar"
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("r", b.R)
+	addIfNotEmpty("theta", b.Theta)
+	if b.ThetaUnit != "" {
+		m["thetaunit"] = b.ThetaUnit
+	}
+	addIfNotEmpty("width", b.Width)
+	addIfNotEmpty("base", b.Base)
+	addIfNotEmpty("offset", b.Offset)
+
+	// Styling
+	if b.Marker != nil {
+		m["marker"] = b.Marker
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", b.Text)
+	addIfNotEmpty("hovertext", b.HoverText)
+	if b.HoverTemplate != "" {
+		m["hovertemplate"] = b.HoverTemplate
+	}
+	if b.HoverLabel != nil {
+		m["hoverlabel"] = b.HoverLabel
+	}
+
+	// Layout Properties
+	if b.Subplot != "" {
+		m["subplot"] = b.Subplot
+	}
+	if b.LegendGroup != "" {
+		m["legendgroup"] = b.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,106 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBarpolarValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Barpolar)
+		expectedError string
+	}{
+		{
+			name:          "valid barpolar",
+			setup:         func(b *Barpolar) {},
+			expectedError: "",
+		},
+		{
+			name: "missing r",
+			setup: func(b *Barpolar) {
+				b.R = nil
+			},
+			expectedError: "R must be provided",
+		},
+		{
+			name: "r and theta length mismatch",
+			setup: func(b *Barpolar) {
+				b.Theta = []string{"N", "E"}
+			},
+			expectedError: "r and theta must have the same length (4 != 2)",
+		},
+		{
+			name: "width per bar",
+			setup: func(b *Barpolar) {
+				b.Width = []float64{90, 45, 90, 45}
+			},
+			expectedError: "",
+		},
+		{
+			name: "width count mismatch",
+			setup: func(b *Barpolar) {
+				b.Width = []float64{90, 45}
+			},
+			expectedError: "2 widths given for 4 bars",
+		},
+		{
+			name: "negative width",
+			setup: func(b *Barpolar) {
+				b.Width = -10
+			},
+			expectedError: "width must be non-negative",
+		},
+		{
+			name: "invalid width",
+			setup: func(b *Barpolar) {
+				b.Width = "wide"
+			},
+			expectedError: "width must be a number or an array of numbers",
+		},
+		{
+			name: "invalid theta unit",
+			setup: func(b *Barpolar) {
+				b.ThetaUnit = "turns"
+			},
+			expectedError: "invalid theta unit: turns",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBarpolar()
+			b.R = []float64{3, 1, 4, 1}
+			b.Theta = []string{"N", "E", "S", "W"}
+			tt.setup(b)
+
+			err := b.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestBarpolarMarshalJSON(t *testing.T) {
+	b := NewBarpolar()
+	b.R = []float64{3, 1}
+	b.Theta = []float64{0, 90}
+	b.Width = 45
+	b.Marker = &BarMarker{Color: "#1f77b4"}
+
+	data, err := json.Marshal(b)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"barpolar"`)
+	assert.Contains(t, jsonStr, `"r":[3,1]`)
+	assert.Contains(t, jsonStr, `"width":45`)
+	assert.Contains(t, jsonStr, `"marker":{"color":"#1f77b4"}`)
+	assert.NotContains(t, jsonStr, `"base"`)
+}
@@ -3,6 +3,7 @@ package graph_objects
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/ekinolik/go-plotly/pkg/validation"
 )
@@ -241,34 +242,9 @@ func (b *Box) Validate() error {
 		return err
 	}
 
-	// Validate orientation if specified
-	if b.Orientation != "" {
-		validOrientations := map[string]bool{
-			string(BoxOrientationVertical):   true,
-			string(BoxOrientationHorizontal): true,
-		}
-		if !validOrientations[b.Orientation] {
-			return &validation.ValidationError{
-				Field:   "Orientation",
-				Message: fmt.Sprintf("invalid orientation: %s", b.Orientation),
-			}
-		}
-	}
-
-	// Validate boxpoints if specified
-	if b.BoxPoints != "" {
-		validBoxPoints := map[string]bool{
-			"all":               true,
-			"outliers":          true,
-			"suspectedoutliers": true,
-			"false":             true,
-		}
-		if !validBoxPoints[b.BoxPoints] {
-			return &validation.ValidationError{
-				Field:   "BoxPoints",
-				Message: fmt.Sprintf("invalid boxpoints: %s", b.BoxPoints),
-			}
-		}
+	// Validate orientation and sample points
+	if err := validateSamplePoints("BoxPoints", b.Orientation, b.BoxPoints, b.JitterWidth, b.PointPos); err != nil {
+		return err
 	}
 
 	// Validate that X or Y is present
@@ -280,34 +256,8 @@ func (b *Box) Validate() error {
 	}
 
 	// Validate quartile method if specified
-	if b.QuartileMethod != "" {
-		validMethods := map[string]bool{
-			string(QuartileLinear):    true,
-			string(QuartileExclusive): true,
-			string(QuartileInclusive): true,
-		}
-		if !validMethods[b.QuartileMethod] {
-			return &validation.ValidationError{
-				Field:   "QuartileMethod",
-				Message: fmt.Sprintf("invalid quartile method: %s", b.QuartileMethod),
-			}
-		}
-	}
-
-	// Validate jitter width range
-	if b.JitterWidth != 0 && (b.JitterWidth < 0 || b.JitterWidth > 1) {
-		return &validation.ValidationError{
-			Field:   "JitterWidth",
-			Message: "jitter width must be between 0 and 1",
-		}
-	}
-
-	// Validate point position range
-	if b.PointPos != 0 && (b.PointPos < -2 || b.PointPos > 2) {
-		return &validation.ValidationError{
-			Field:   "PointPos",
-			Message: "point position must be between -2 and 2",
-		}
+	if err := validateQuartileMethod(b.QuartileMethod); err != nil {
+		return err
 	}
 
 	// Validate hover info
@@ -417,7 +367,7 @@ func (b *Box) Validate() error {
 
 	// Validate marker properties
 	if b.Marker != nil {
-		if err := b.validateMarker(); err != nil {
+		if err := validatePointMarker(b.Marker); err != nil {
 			return err
 		}
 	}
@@ -506,11 +456,81 @@ func (b *Box) Validate() error {
 	return nil
 }
 
-// Add helper validation methods
+// validateSamplePoints validates the orientation and the display of sample
+// points shared by box and violin traces
+func validateSamplePoints(pointsField, orientation, points string, jitter, pointPos float64) error {
+	// Validate orientation if specified
+	if orientation != "" {
+		validOrientations := map[string]bool{
+			string(BoxOrientationVertical):   true,
+			string(BoxOrientationHorizontal): true,
+		}
+		if !validOrientations[orientation] {
+			return &validation.ValidationError{
+				Field:   "Orientation",
+				Message: fmt.Sprintf("invalid orientation: %s", orientation),
+			}
+		}
+	}
 
-func (b *Box) validateMarker() error {
-	m := b.Marker
+	// Validate point mode if specified
+	if points != "" {
+		validPoints := map[string]bool{
+			BoxPointsAll:               true,
+			BoxPointsOutliers:          true,
+			BoxPointsSuspectedOutliers: true,
+			BoxPointsFalse:             true,
+		}
+		if !validPoints[points] {
+			return &validation.ValidationError{
+				Field:   pointsField,
+				Message: fmt.Sprintf("invalid %s: %s", strings.ToLower(pointsField), points),
+			}
+		}
+	}
+
+	// Validate jitter width range
+	if jitter != 0 && (jitter < 0 || jitter > 1) {
+		return &validation.ValidationError{
+			Field:   "JitterWidth",
+			Message: "jitter width must be between 0 and 1",
+		}
+	}
+
+	// Validate point position range
+	if pointPos != 0 && (pointPos < -2 || pointPos > 2) {
+		return &validation.ValidationError{
+			Field:   "PointPos",
+			Message: "point position must be between -2 and 2",
+		}
+	}
+
+	return nil
+}
+
+// validateQuartileMethod validates the quartile method shared by box and
+// violin traces
+func validateQuartileMethod(method string) error {
+	if method == "" {
+		return nil
+	}
+	validMethods := map[string]bool{
+		string(QuartileLinear):    true,
+		string(QuartileExclusive): true,
+		string(QuartileInclusive): true,
+	}
+	if !validMethods[method] {
+		return &validation.ValidationError{
+			Field:   "QuartileMethod",
+			Message: fmt.Sprintf("invalid quartile method: %s", method),
+		}
+	}
+	return nil
+}
 
+// validatePointMarker validates the sample point markers shared by box and
+// violin traces
+func validatePointMarker(m *BoxMarker) error {
 	// Validate opacity range
 	if m.Opacity != nil {
 		opacity, ok := m.Opacity.(float64)
@@ -543,7 +563,7 @@ func (b *Box) validateMarker() error {
 
 	// Validate outlier marker if present
 	if m.Outlier != nil {
-		if err := b.validateOutlierMarker(m.Outlier); err != nil {
+		if err := validateOutlierMarker(m.Outlier); err != nil {
 			return err
 		}
 	}
@@ -551,7 +571,7 @@ func (b *Box) validateMarker() error {
 	return nil
 }
 
-func (b *Box) validateOutlierMarker(m *BoxMarker) error {
+func validateOutlierMarker(m *BoxMarker) error {
 	if m.Opacity != nil {
 		opacity, ok := m.Opacity.(float64)
 		if ok && (opacity < 0 || opacity > 1) {
@@ -829,7 +849,7 @@ func (b *Box) MarshalJSON() ([]byte, error) {
 	m := make(map[string]interface{})
 
 	// Add base trace fields
-	baseData, err := json.Marshal(b.BaseTrace)
+	baseData, err := json.Marshal(&b.BaseTrace)
 	if err != nil {
 		return nil, err
 	}
@@ -225,14 +225,9 @@ func TestBox_ValidateMarker(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			box := &Box{
-				BaseTrace: BaseTrace{Type: "box"},
-				Y:         []float64{1, 2, 3},
-				Marker:    tt.marker,
-			}
-			err := box.validateMarker()
+			err := validatePointMarker(tt.marker)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("Box.validateMarker() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("validatePointMarker() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
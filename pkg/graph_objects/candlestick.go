@@ -0,0 +1,295 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Candlestick represents a candlestick trace
+type Candlestick struct {
+	BaseTrace
+	// Data (required fields)
+	X     interface{} `json:"x"`     // array of dates/categories
+	Open  interface{} `json:"open"`  // array of open values
+	High  interface{} `json:"high"`  // array of high values
+	Low   interface{} `json:"low"`   // array of low values
+	Close interface{} `json:"close"` // array of close values
+
+	// Line Properties
+	Line *CandlestickLine `json:"line,omitempty"`
+
+	// Increasing/Decreasing Properties
+	Increasing *CandlestickDirection `json:"increasing,omitempty"`
+	Decreasing *CandlestickDirection `json:"decreasing,omitempty"`
+
+	// WhiskerWidth is the width of the whiskers relative to the box width (0-1)
+	WhiskerWidth *float64 `json:"whiskerwidth,omitempty"`
+
+	// Text and Hover Properties
+	Text       interface{} `json:"text,omitempty"`
+	HoverText  interface{} `json:"hovertext,omitempty"`
+	HoverLabel *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	XAxis            string      `json:"xaxis,omitempty"`
+	YAxis            string      `json:"yaxis,omitempty"`
+	LegendGroup      string      `json:"legendgroup,omitempty"`
+	LegendRank       int         `json:"legendrank,omitempty"`
+	XPeriod          interface{} `json:"xperiod,omitempty"`
+	XPeriodAlignment string      `json:"xperiodalignment,omitempty"`
+	XPeriod0         interface{} `json:"xperiod0,omitempty"`
+	XCalendar        string      `json:"xcalendar,omitempty"`
+	XHoverFormat     string      `json:"xhoverformat,omitempty"`
+	YHoverFormat     string      `json:"yhoverformat,omitempty"`
+	UIRevision       interface{} `json:"uirevision,omitempty"`
+	SelectedPoints   interface{} `json:"selectedpoints,omitempty"`
+
+	// Advanced Properties
+	IDs interface{} `json:"ids,omitempty"`
+}
+
+// CandlestickLine represents the line properties of a candlestick trace
+type CandlestickLine struct {
+	Width float64 `json:"width,omitempty"`
+}
+
+// CandlestickDirection represents the style of increasing or decreasing
+// candles
+type CandlestickDirection struct {
+	FillColor string                    `json:"fillcolor,omitempty"`
+	Line      *CandlestickDirectionLine `json:"line,omitempty"`
+}
+
+// CandlestickDirectionLine represents the box and whisker line of increasing
+// or decreasing candles
+type CandlestickDirectionLine struct {
+	Color string  `json:"color,omitempty"`
+	Width float64 `json:"width,omitempty"`
+}
+
+// NewCandlestick creates a new candlestick trace
+func NewCandlestick() *Candlestick {
+	return &Candlestick{
+		BaseTrace: BaseTrace{
+			Type: "candlestick",
+		},
+	}
+}
+
+// NewCandlestickFromOHLC creates a candlestick trace with the data, legend,
+// axis and hover properties of an OHLC trace. Increasing and decreasing
+// colors and line widths are used for the candle lines.
+func NewCandlestickFromOHLC(o *OHLC) *Candlestick {
+	c := NewCandlestick()
+	c.X = o.X
+	c.Open = o.Open
+	c.High = o.High
+	c.Low = o.Low
+	c.Close = o.Close
+
+	// OHLC declares its own name, visibility, legend and opacity fields
+	c.Name = o.Name
+	if c.Name == "" {
+		c.Name = o.BaseTrace.Name
+	}
+	c.Visible = o.Visible
+	if c.Visible == nil {
+		c.Visible = o.BaseTrace.Visible
+	}
+	c.ShowLegend = o.ShowLegend
+	if c.ShowLegend == nil {
+		c.ShowLegend = o.BaseTrace.ShowLegend
+	}
+	c.Opacity = o.BaseTrace.Opacity
+	if o.Opacity != 0 {
+		c.Opacity = Float64(o.Opacity)
+	}
+	c.HoverInfo = o.HoverInfo
+	if c.HoverInfo == "" {
+		c.HoverInfo = o.BaseTrace.HoverInfo
+	}
+	c.CustomData = o.CustomData
+	if c.CustomData == nil {
+		c.CustomData = o.BaseTrace.CustomData
+	}
+	c.Meta = o.Meta
+	if c.Meta == nil {
+		c.Meta = o.BaseTrace.Meta
+	}
+
+	if o.Line != nil {
+		c.Line = &CandlestickLine{Width: o.Line.Width}
+	}
+	c.Increasing = candlestickDirection(o.Increasing)
+	c.Decreasing = candlestickDirection(o.Decreasing)
+
+	c.Text = o.Text
+	c.HoverText = o.HoverText
+	c.HoverLabel = o.HoverLabel
+	c.XAxis = o.XAxis
+	c.YAxis = o.YAxis
+	c.LegendGroup = o.LegendGroup
+	c.LegendRank = o.LegendRank
+	c.XPeriod = o.XPeriod
+	c.XPeriodAlignment = o.XPeriodAlign
+	c.XPeriod0 = o.XPeriod0
+	c.XCalendar = o.XCalendar
+	c.XHoverFormat = o.XHoverFormat
+	c.YHoverFormat = o.YHoverFormat
+	c.UIRevision = o.UIRevision
+	c.SelectedPoints = o.SelectedPoints
+	c.IDs = o.IDs
+
+	return c
+}
+
+// candlestickDirection converts the style of increasing or decreasing OHLC
+// ticks to candle lines
+func candlestickDirection(d *OHLCDirection) *CandlestickDirection {
+	if d == nil {
+		return nil
+	}
+	line := &CandlestickDirectionLine{Color: d.Color}
+	if d.Line != nil {
+		line.Width = d.Line.Width
+	}
+	return &CandlestickDirection{Line: line}
+}
+
+// Validate implements the Validator interface
+func (c *Candlestick) Validate() error {
+	if err := c.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateOHLCData(c.Open, c.High, c.Low, c.Close); err != nil {
+		return err
+	}
+
+	// Validate line properties
+	if c.Line != nil && c.Line.Width < 0 {
+		return &validation.ValidationError{
+			Field:   "Line.Width",
+			Message: "line width must be non-negative",
+		}
+	}
+
+	// Validate increasing/decreasing properties
+	if err := c.validateDirection(c.Increasing, "Increasing"); err != nil {
+		return err
+	}
+	if err := c.validateDirection(c.Decreasing, "Decreasing"); err != nil {
+		return err
+	}
+
+	// Validate whisker width
+	if c.WhiskerWidth != nil && (*c.WhiskerWidth < 0 || *c.WhiskerWidth > 1) {
+		return &validation.ValidationError{
+			Field:   "WhiskerWidth",
+			Message: "whisker width must be between 0 and 1",
+		}
+	}
+
+	return nil
+}
+
+func (c *Candlestick) validateDirection(dir *CandlestickDirection, field string) error {
+	if dir == nil || dir.Line == nil {
+		return nil
+	}
+	if dir.Line.Width < 0 {
+		return &validation.ValidationError{
+			Field:   fmt.Sprintf("%s.Line.Width", field),
+			Message: "line width must be non-negative",
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (c *Candlestick) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&c.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and required data fields
+	m["type"] = "candlestick"
+	m["x"] = c.X
+	m["open"] = c.Open
+	m["high"] = c.High
+	m["low"] = c.Low
+	m["close"] = c.Close
+
+	// Add optional fields if present
+	if c.Line != nil {
+		m["line"] = c.Line
+	}
+	if c.Increasing != nil {
+		m["increasing"] = c.Increasing
+	}
+	if c.Decreasing != nil {
+		m["decreasing"] = c.Decreasing
+	}
+	if c.WhiskerWidth != nil {
+		m["whiskerwidth"] = *c.WhiskerWidth
+	}
+	if c.Text != nil {
+		m["text"] = c.Text
+	}
+	if c.HoverText != nil {
+		m["hovertext"] = c.HoverText
+	}
+	if c.HoverLabel != nil {
+		m["hoverlabel"] = c.HoverLabel
+	}
+	if c.XAxis != "" {
+		m["xaxis"] = c.XAxis
+	}
+	if c.YAxis != "" {
+		m["yaxis"] = c.YAxis
+	}
+	if c.LegendGroup != "" {
+		m["legendgroup"] = c.LegendGroup
+	}
+	if c.LegendRank != 0 {
+		m["legendrank"] = c.LegendRank
+	}
+	if c.XPeriod != nil {
+		m["xperiod"] = c.XPeriod
+	}
+	if c.XPeriodAlignment != "" {
+		m["xperiodalignment"] = c.XPeriodAlignment
+	}
+	if c.XPeriod0 != nil {
+		m["xperiod0"] = c.XPeriod0
+	}
+	if c.XCalendar != "" {
+		m["xcalendar"] = c.XCalendar
+	}
+	if c.XHoverFormat != "" {
+		m["xhoverformat"] = c.XHoverFormat
+	}
+	if c.YHoverFormat != "" {
+		m["yhoverformat"] = c.YHoverFormat
+	}
+	if c.UIRevision != nil {
+		m["uirevision"] = c.UIRevision
+	}
+	if c.SelectedPoints != nil {
+		m["selectedpoints"] = c.SelectedPoints
+	}
+	if c.IDs != nil {
+		m["ids"] = c.IDs
+	}
+
+	return json.Marshal(m)
+}
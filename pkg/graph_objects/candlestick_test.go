@@ -0,0 +1,159 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandlestickValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Candlestick)
+		expectedError string
+	}{
+		{
+			name:          "valid data",
+			setup:         func(c *Candlestick) {},
+			expectedError: "",
+		},
+		{
+			name: "missing close",
+			setup: func(c *Candlestick) {
+				c.Close = nil
+			},
+			expectedError: "all OHLC values (open, high, low, close) must be provided",
+		},
+		{
+			name: "invalid open type",
+			setup: func(c *Candlestick) {
+				c.Open = []string{"2", "3"}
+			},
+			expectedError: "open values must be an array of numbers",
+		},
+		{
+			name: "mismatched array lengths",
+			setup: func(c *Candlestick) {
+				c.Low = []float64{1}
+			},
+			expectedError: "all OHLC arrays must have the same length",
+		},
+		{
+			name: "close above high",
+			setup: func(c *Candlestick) {
+				c.Close = []float64{5, 2}
+			},
+			expectedError: "Data Point 0: close (5.00) must be between low (1.00) and high (4.00)",
+		},
+		{
+			name: "negative line width",
+			setup: func(c *Candlestick) {
+				c.Line = &CandlestickLine{Width: -1}
+			},
+			expectedError: "line width must be non-negative",
+		},
+		{
+			name: "negative increasing line width",
+			setup: func(c *Candlestick) {
+				c.Increasing = &CandlestickDirection{Line: &CandlestickDirectionLine{Width: -1}}
+			},
+			expectedError: "Increasing.Line.Width",
+		},
+		{
+			name: "whisker width above 1",
+			setup: func(c *Candlestick) {
+				c.WhiskerWidth = Float64(1.5)
+			},
+			expectedError: "whisker width must be between 0 and 1",
+		},
+		{
+			name: "zero whisker width",
+			setup: func(c *Candlestick) {
+				c.WhiskerWidth = Float64(0)
+			},
+			expectedError: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCandlestick()
+			c.X = []string{"2024-01-01", "2024-01-02"}
+			c.Open = []float64{2, 3}
+			c.High = []float64{4, 6}
+			c.Low = []float64{1, 1}
+			c.Close = []float64{3, 2}
+			tt.setup(c)
+
+			err := c.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestCandlestickMarshalJSON(t *testing.T) {
+	c := NewCandlestick()
+	c.X = []string{"2024-01-01", "2024-01-02"}
+	c.Open = []float64{33.0, 32.0}
+	c.High = []float64{34.0, 33.0}
+	c.Low = []float64{32.0, 31.0}
+	c.Close = []float64{33.5, 31.5}
+	c.Name = "Test Candlestick"
+	c.WhiskerWidth = Float64(0)
+	c.Increasing = &CandlestickDirection{
+		FillColor: "#3D9970",
+		Line:      &CandlestickDirectionLine{Color: "#2E7D32", Width: 1},
+	}
+
+	data, err := c.MarshalJSON()
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"candlestick"`)
+	assert.Contains(t, jsonStr, `"open":[33,32]`)
+	assert.Contains(t, jsonStr, `"close":[33.5,31.5]`)
+	assert.Contains(t, jsonStr, `"name":"Test Candlestick"`)
+	assert.Contains(t, jsonStr, `"whiskerwidth":0`)
+	assert.Contains(t, jsonStr, `"increasing":{"fillcolor":"#3D9970","line":{"color":"#2E7D32","width":1}}`)
+	assert.NotContains(t, jsonStr, `"decreasing"`)
+}
+
+func TestNewCandlestickFromOHLC(t *testing.T) {
+	o := NewOHLC()
+	o.X = []string{"2024-01-01", "2024-01-02"}
+	o.Open = []float64{2, 3}
+	o.High = []float64{4, 6}
+	o.Low = []float64{1, 1}
+	o.Close = []float64{3, 2}
+	o.Name = "Stock Price"
+	o.Opacity = 0.8
+	o.ShowLegend = Bool(false)
+	o.XAxis = "x2"
+	o.Line = &OHLCLine{Width: 2, Dash: DashDot}
+	o.Increasing = &OHLCDirection{Color: "#3D9970", Line: &OHLCLine{Width: 1}}
+	o.Decreasing = &OHLCDirection{Color: "#FF4136"}
+
+	c := NewCandlestickFromOHLC(o)
+	assert.NoError(t, c.Validate())
+	assert.Equal(t, "candlestick", c.TraceType())
+	assert.Equal(t, o.Open, c.Open)
+	assert.Equal(t, o.Close, c.Close)
+	assert.Equal(t, "Stock Price", c.GetName())
+	assert.Equal(t, Float64(0.8), c.Opacity)
+	assert.Equal(t, Bool(false), c.ShowLegend)
+	assert.Equal(t, "x2", c.XAxis)
+	assert.Equal(t, &CandlestickLine{Width: 2}, c.Line)
+	assert.Equal(t, &CandlestickDirection{Line: &CandlestickDirectionLine{Color: "#3D9970", Width: 1}}, c.Increasing)
+	assert.Equal(t, &CandlestickDirection{Line: &CandlestickDirectionLine{Color: "#FF4136"}}, c.Decreasing)
+
+	data, err := json.Marshal(c)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"name":"Stock Price"`)
+	assert.Contains(t, string(data), `"opacity":0.8`)
+}
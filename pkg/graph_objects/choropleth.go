@@ -0,0 +1,188 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Choropleth represents a choropleth map trace, drawn as regions of a
+// geographic map colored by value
+type Choropleth struct {
+	BaseTrace
+	// Data
+	Locations    []string    `json:"locations"`
+	Z            []float64   `json:"z"`                      // one value per location
+	LocationMode string      `json:"locationmode,omitempty"` // "ISO-3", "USA-states", "country names" or "geojson-id"
+	GeoJSON      interface{} `json:"geojson,omitempty"`      // *GeoJSON, a decoded document or a URL
+	FeatureIDKey string      `json:"featureidkey,omitempty"` // "id" or a property such as "properties.name"
+
+	// Styling
+	Marker *ChoroplethMarker `json:"marker,omitempty"`
+
+	// Color Properties
+	ColorScale   interface{} `json:"colorscale,omitempty"` // name or [position, color] pairs
+	ReverseScale *bool       `json:"reversescale,omitempty"`
+	ShowScale    *bool       `json:"showscale,omitempty"`
+	ZMin         *float64    `json:"zmin,omitempty"`
+	ZMax         *float64    `json:"zmax,omitempty"`
+	ZMid         *float64    `json:"zmid,omitempty"`
+	ZAuto        *bool       `json:"zauto,omitempty"`
+	ColorBar     *ColorBar   `json:"colorbar,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	Geo         string `json:"geo,omitempty"` // e.g. "geo" or "geo2"
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// ChoroplethMarker represents the styling of choropleth regions
+type ChoroplethMarker struct {
+	Line    *MarkerLine `json:"line,omitempty"`    // region borders
+	Opacity interface{} `json:"opacity,omitempty"` // number or array
+}
+
+// NewChoropleth creates a new choropleth trace
+func NewChoropleth() *Choropleth {
+	return &Choropleth{
+		BaseTrace: BaseTrace{
+			Type: "choropleth",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (c *Choropleth) Validate() error {
+	if err := c.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	// Validate that locations and values are present with one value per
+	// location
+	if len(c.Locations) == 0 || len(c.Z) == 0 {
+		return &validation.ValidationError{
+			Field:   "Locations/Z",
+			Message: "Locations and Z must be provided",
+		}
+	}
+	if len(c.Locations) != len(c.Z) {
+		return &validation.ValidationError{
+			Field:   "Locations/Z",
+			Message: fmt.Sprintf("locations and z must have the same length (%d != %d)", len(c.Locations), len(c.Z)),
+		}
+	}
+	if err := validateGeoLocations(c.Locations, c.LocationMode, c.GeoJSON, c.FeatureIDKey); err != nil {
+		return err
+	}
+
+	if c.Marker != nil {
+		opacities, ok := toFloat64Slice(c.Marker.Opacity)
+		if !ok {
+			if opacity, isNumber := toFloat64(c.Marker.Opacity); isNumber {
+				opacities = []float64{opacity}
+			}
+		}
+		for _, opacity := range opacities {
+			if opacity < 0 || opacity > 1 {
+				return &validation.ValidationError{
+					Field:   "Marker.Opacity",
+					Message: "opacity must be between 0 and 1",
+				}
+			}
+		}
+	}
+
+	if err := validateColorScale("ColorScale", c.ColorScale); err != nil {
+		return err
+	}
+	return validateColorRange("ZMin", c.ZMin, "ZMax", c.ZMax)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (c *Choropleth) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&c.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and data
+	m["type"] = "choropleth"
+	m["locations"] = c.Locations
+	m["z"] = c.Z
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	if c.LocationMode != "" {
+		m["locationmode"] = c.LocationMode
+	}
+	addIfNotEmpty("geojson", c.GeoJSON)
+	if c.FeatureIDKey != "" {
+		m["featureidkey"] = c.FeatureIDKey
+	}
+
+	// Styling
+	if c.Marker != nil {
+		m["marker"] = c.Marker
+	}
+
+	// Color Properties
+	addIfNotEmpty("colorscale", c.ColorScale)
+	if c.ReverseScale != nil {
+		m["reversescale"] = *c.ReverseScale
+	}
+	if c.ShowScale != nil {
+		m["showscale"] = *c.ShowScale
+	}
+	if c.ZMin != nil {
+		m["zmin"] = *c.ZMin
+	}
+	if c.ZMax != nil {
+		m["zmax"] = *c.ZMax
+	}
+	if c.ZMid != nil {
+		m["zmid"] = *c.ZMid
+	}
+	if c.ZAuto != nil {
+		m["zauto"] = *c.ZAuto
+	}
+	if c.ColorBar != nil {
+		m["colorbar"] = c.ColorBar
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", c.Text)
+	addIfNotEmpty("hovertext", c.HoverText)
+	if c.HoverTemplate != "" {
+		m["hovertemplate"] = c.HoverTemplate
+	}
+	if c.HoverLabel != nil {
+		m["hoverlabel"] = c.HoverLabel
+	}
+
+	// Layout Properties
+	if c.Geo != "" {
+		m["geo"] = c.Geo
+	}
+	if c.LegendGroup != "" {
+		m["legendgroup"] = c.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,218 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChoroplethValidation(t *testing.T) {
+	regions, err := ParseGeoJSON([]byte(testRegionsGeoJSON))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		setup         func() *Choropleth
+		expectedError string
+	}{
+		{
+			name: "valid country codes",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"FRA", "DEU", "ITA"}
+				c.Z = []float64{120, 340, 95}
+				c.LocationMode = LocationModeISO3
+				return c
+			},
+			expectedError: "",
+		},
+		{
+			name: "valid geojson",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"eu-west", "eu-north"}
+				c.Z = []float64{1200, 800}
+				c.GeoJSON = regions
+				return c
+			},
+			expectedError: "",
+		},
+		{
+			name: "valid geojson property key",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"EU North"}
+				c.Z = []float64{800}
+				c.GeoJSON = regions
+				c.FeatureIDKey = "properties.name"
+				c.LocationMode = LocationModeGeoJSONID
+				return c
+			},
+			expectedError: "",
+		},
+		{
+			name: "valid geojson url",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"06"}
+				c.Z = []float64{1}
+				c.GeoJSON = "https://example.com/counties.json"
+				return c
+			},
+			expectedError: "",
+		},
+		{
+			name: "missing z",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"FRA"}
+				return c
+			},
+			expectedError: "Locations and Z must be provided",
+		},
+		{
+			name: "mismatched lengths",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"FRA", "DEU"}
+				c.Z = []float64{1}
+				return c
+			},
+			expectedError: "locations and z must have the same length (2 != 1)",
+		},
+		{
+			name: "unknown geojson location",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"EU West", "EU South"}
+				c.Z = []float64{1, 2}
+				c.GeoJSON = regions
+				c.FeatureIDKey = "properties.name"
+				return c
+			},
+			expectedError: `location "EU South" at index 1 does not match the properties.name of any GeoJSON feature`,
+		},
+		{
+			name: "unknown location in decoded geojson",
+			setup: func() *Choropleth {
+				var decoded map[string]interface{}
+				if err := json.Unmarshal([]byte(testRegionsGeoJSON), &decoded); err != nil {
+					t.Fatal(err)
+				}
+				c := NewChoropleth()
+				c.Locations = []string{"eu-east"}
+				c.Z = []float64{1}
+				c.GeoJSON = decoded
+				return c
+			},
+			expectedError: `location "eu-east" at index 0 does not match the id of any GeoJSON feature`,
+		},
+		{
+			name: "invalid geojson",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"eu-west"}
+				c.Z = []float64{1}
+				c.GeoJSON = map[string]interface{}{"type": "Point"}
+				return c
+			},
+			expectedError: "invalid GeoJSON",
+		},
+		{
+			name: "geojson id mode without geojson",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"eu-west"}
+				c.Z = []float64{1}
+				c.LocationMode = LocationModeGeoJSONID
+				return c
+			},
+			expectedError: "GeoJSON must be provided with the geojson-id location mode",
+		},
+		{
+			name: "geojson with another location mode",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"eu-west"}
+				c.Z = []float64{1}
+				c.GeoJSON = regions
+				c.LocationMode = LocationModeISO3
+				return c
+			},
+			expectedError: "GeoJSON is only used with the geojson-id location mode",
+		},
+		{
+			name: "invalid feature id key",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"eu-west"}
+				c.Z = []float64{1}
+				c.GeoJSON = regions
+				c.FeatureIDKey = "name"
+				return c
+			},
+			expectedError: `feature ID key must be "id" or start with "properties."`,
+		},
+		{
+			name: "invalid marker opacity",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"FRA"}
+				c.Z = []float64{1}
+				c.Marker = &ChoroplethMarker{Opacity: 1.5}
+				return c
+			},
+			expectedError: "opacity must be between 0 and 1",
+		},
+		{
+			name: "invalid z range",
+			setup: func() *Choropleth {
+				c := NewChoropleth()
+				c.Locations = []string{"FRA"}
+				c.Z = []float64{1}
+				c.ZMin = Float64(10)
+				c.ZMax = Float64(5)
+				return c
+			},
+			expectedError: "zmin must be less than zmax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.setup().Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestChoroplethMarshalJSON(t *testing.T) {
+	regions, err := ParseGeoJSON([]byte(testRegionsGeoJSON))
+	assert.NoError(t, err)
+
+	c := NewChoropleth()
+	c.Locations = []string{"eu-west", "eu-north"}
+	c.Z = []float64{1200, 800}
+	c.GeoJSON = regions
+	c.FeatureIDKey = "id"
+	c.ColorScale = "Viridis"
+	c.Marker = &ChoroplethMarker{Line: &MarkerLine{Color: "white", Width: 1}}
+
+	data, err := json.Marshal(c)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"choropleth"`)
+	assert.Contains(t, jsonStr, `"locations":["eu-west","eu-north"]`)
+	assert.Contains(t, jsonStr, `"z":[1200,800]`)
+	assert.Contains(t, jsonStr, `"geojson":{"type":"FeatureCollection","features":[{"type":"Feature","id":"eu-west"`)
+	assert.Contains(t, jsonStr, `"featureidkey":"id"`)
+	assert.Contains(t, jsonStr, `"colorscale":"Viridis"`)
+	assert.Contains(t, jsonStr, `"marker":{"line":{"color":"white","width":1}}`)
+}
@@ -0,0 +1,141 @@
+package graph_objects
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Named colorscales built into plotly.js. A ColorScale field holds either one
+// of these names or a list of [position, color] pairs with positions going
+// from 0 to 1, e.g. [][]interface{}{{0, "white"}, {1, "red"}}.
+const (
+	ColorScaleBlackbody = "Blackbody"
+	ColorScaleBluered   = "Bluered"
+	ColorScaleBlues     = "Blues"
+	ColorScaleCividis   = "Cividis"
+	ColorScaleEarth     = "Earth"
+	ColorScaleElectric  = "Electric"
+	ColorScaleGreens    = "Greens"
+	ColorScaleGreys     = "Greys"
+	ColorScaleHot       = "Hot"
+	ColorScaleJet       = "Jet"
+	ColorScalePicnic    = "Picnic"
+	ColorScalePortland  = "Portland"
+	ColorScaleRainbow   = "Rainbow"
+	ColorScaleRdBu      = "RdBu"
+	ColorScaleReds      = "Reds"
+	ColorScaleViridis   = "Viridis"
+	ColorScaleYlGnBu    = "YlGnBu"
+	ColorScaleYlOrRd    = "YlOrRd"
+)
+
+var namedColorScales = map[string]bool{
+	strings.ToLower(ColorScaleBlackbody): true,
+	strings.ToLower(ColorScaleBluered):   true,
+	strings.ToLower(ColorScaleBlues):     true,
+	strings.ToLower(ColorScaleCividis):   true,
+	strings.ToLower(ColorScaleEarth):     true,
+	strings.ToLower(ColorScaleElectric):  true,
+	strings.ToLower(ColorScaleGreens):    true,
+	strings.ToLower(ColorScaleGreys):     true,
+	strings.ToLower(ColorScaleHot):       true,
+	strings.ToLower(ColorScaleJet):       true,
+	strings.ToLower(ColorScalePicnic):    true,
+	strings.ToLower(ColorScalePortland):  true,
+	strings.ToLower(ColorScaleRainbow):   true,
+	strings.ToLower(ColorScaleRdBu):      true,
+	strings.ToLower(ColorScaleReds):      true,
+	strings.ToLower(ColorScaleViridis):   true,
+	strings.ToLower(ColorScaleYlGnBu):    true,
+	strings.ToLower(ColorScaleYlOrRd):    true,
+}
+
+// validateColorScale checks that a colorscale is a known name or a list of
+// [position, color] pairs with increasing positions from 0 to 1
+func validateColorScale(field string, colorScale interface{}) error {
+	switch cs := colorScale.(type) {
+	case nil:
+		return nil
+	case string:
+		if !namedColorScales[strings.ToLower(cs)] {
+			return &validation.ValidationError{
+				Field:   field,
+				Message: fmt.Sprintf("invalid colorscale name: %s", cs),
+			}
+		}
+		return nil
+	case [][]interface{}:
+		stops := make([]interface{}, len(cs))
+		for i, stop := range cs {
+			stops[i] = stop
+		}
+		return validateColorScaleStops(field, stops)
+	case []interface{}:
+		return validateColorScaleStops(field, cs)
+	}
+	return &validation.ValidationError{
+		Field:   field,
+		Message: "colorscale must be a name or a list of [position, color] pairs",
+	}
+}
+
+func validateColorScaleStops(field string, stops []interface{}) error {
+	if len(stops) < 2 {
+		return &validation.ValidationError{
+			Field:   field,
+			Message: "colorscale must have at least two [position, color] pairs",
+		}
+	}
+
+	previous := 0.0
+	for i, s := range stops {
+		stop, ok := s.([]interface{})
+		if !ok || len(stop) != 2 {
+			return &validation.ValidationError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				Message: "colorscale entries must be [position, color] pairs",
+			}
+		}
+		position, ok := toFloat64(stop[0])
+		if !ok {
+			return &validation.ValidationError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				Message: "colorscale position must be a number",
+			}
+		}
+		if _, ok := stop[1].(string); !ok {
+			return &validation.ValidationError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				Message: "colorscale color must be a string",
+			}
+		}
+		if position < previous || position > 1 {
+			return &validation.ValidationError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				Message: "colorscale positions must increase from 0 to 1",
+			}
+		}
+		if (i == 0 && position != 0) || (i == len(stops)-1 && position != 1) {
+			return &validation.ValidationError{
+				Field:   field,
+				Message: "colorscale positions must start at 0 and end at 1",
+			}
+		}
+		previous = position
+	}
+	return nil
+}
+
+// validateColorRange checks that the lower bound of a color range (e.g.
+// zmin/zmax or cmin/cmax) is below the upper bound when both are set
+func validateColorRange(minField string, min *float64, maxField string, max *float64) error {
+	if min != nil && max != nil && *min >= *max {
+		return &validation.ValidationError{
+			Field:   minField + "/" + maxField,
+			Message: fmt.Sprintf("%s must be less than %s", strings.ToLower(minField), strings.ToLower(maxField)),
+		}
+	}
+	return nil
+}
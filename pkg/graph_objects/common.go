@@ -1,5 +1,14 @@
 package graph_objects
 
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
 // Selection represents selection properties
 type Selection struct {
 	Line  interface{} `json:"line,omitempty"`
@@ -35,6 +44,136 @@ type ColorBar struct {
 	ShowScale bool        `json:"showscale,omitempty"`
 }
 
+// Domain represents the placement of a trace such as a pie within the plot
+// area, as fractions of its width and height
+type Domain struct {
+	X      []float64 `json:"x,omitempty"` // [start, end] in [0, 1]
+	Y      []float64 `json:"y,omitempty"` // [start, end] in [0, 1]
+	Row    int       `json:"row,omitempty"`
+	Column int       `json:"column,omitempty"`
+}
+
+// validate checks that the domain ranges are [start, end] pairs within the
+// plot area
+func (d *Domain) validate() error {
+	ranges := []struct {
+		field  string
+		values []float64
+	}{
+		{"Domain.X", d.X},
+		{"Domain.Y", d.Y},
+	}
+	for _, r := range ranges {
+		if r.values == nil {
+			continue
+		}
+		if len(r.values) != 2 || r.values[0] < 0 || r.values[1] > 1 || r.values[0] >= r.values[1] {
+			return &validation.ValidationError{
+				Field:   r.field,
+				Message: "domain must be a [start, end] range within [0, 1]",
+			}
+		}
+	}
+	if d.Row < 0 || d.Column < 0 {
+		return &validation.ValidationError{
+			Field:   "Domain",
+			Message: "grid row and column must be non-negative",
+		}
+	}
+	return nil
+}
+
+// validateFlagList checks that a "+" separated flag list such as
+// "label+percent" only holds valid flags. name describes a flag in the error
+// message, e.g. "textinfo flag".
+func validateFlagList(field, name, value string, validFlags map[string]bool) error {
+	for _, flag := range strings.Split(value, "+") {
+		if !validFlags[flag] {
+			return &validation.ValidationError{
+				Field:   field,
+				Message: fmt.Sprintf("invalid %s: %s", name, flag),
+			}
+		}
+	}
+	return nil
+}
+
+// Bool returns a pointer to the given bool, for optional fields
+func Bool(v bool) *bool {
+	return &v
+}
+
+// Float64 returns a pointer to the given float64, for optional fields
+func Float64(v float64) *float64 {
+	return &v
+}
+
+// toFloat64 converts a single numeric value to float64
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// arrayLength returns the length of array data such as []string or
+// []float64, and false when v is not a slice or array
+func arrayLength(v interface{}) (int, bool) {
+	if v == nil {
+		return 0, false
+	}
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return 0, false
+	}
+	return value.Len(), true
+}
+
+// toFloat64Slice converts numeric array data to []float64. Besides []float64
+// it accepts integer slices and the []interface{} produced by decoding JSON.
+func toFloat64Slice(v interface{}) ([]float64, bool) {
+	switch values := v.(type) {
+	case []float64:
+		return values, true
+	case []int:
+		out := make([]float64, len(values))
+		for i, value := range values {
+			out[i] = float64(value)
+		}
+		return out, true
+	case []interface{}:
+		out := make([]float64, len(values))
+		for i, value := range values {
+			switch n := value.(type) {
+			case float64:
+				out[i] = n
+			case int:
+				out[i] = float64(n)
+			case json.Number:
+				f, err := n.Float64()
+				if err != nil {
+					return nil, false
+				}
+				out[i] = f
+			default:
+				return nil, false
+			}
+		}
+		return out, true
+	}
+	return nil, false
+}
+
 // Common constants
 const (
 	// Period alignments
@@ -0,0 +1,360 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Contour coloring modes
+const (
+	ContourColoringFill    = "fill"
+	ContourColoringHeatmap = "heatmap"
+	ContourColoringLines   = "lines"
+	ContourColoringNone    = "none"
+)
+
+// Contour types
+const (
+	ContourTypeLevels     = "levels"
+	ContourTypeConstraint = "constraint"
+)
+
+// Contour represents a contour trace
+type Contour struct {
+	BaseTrace
+	// Data
+	Z [][]float64 `json:"z"`           // rows of values, NaN for gaps
+	X interface{} `json:"x,omitempty"` // column labels or coordinates
+	Y interface{} `json:"y,omitempty"` // row labels or coordinates
+
+	// Contour Properties
+	Contours    *Contours    `json:"contours,omitempty"`
+	NContours   int          `json:"ncontours,omitempty"`
+	AutoContour *bool        `json:"autocontour,omitempty"`
+	Line        *ContourLine `json:"line,omitempty"`
+	FillColor   string       `json:"fillcolor,omitempty"`
+	ConnectGaps *bool        `json:"connectgaps,omitempty"`
+	HoverOnGaps *bool        `json:"hoverongaps,omitempty"`
+	Transpose   *bool        `json:"transpose,omitempty"`
+
+	// Color Properties
+	ColorScale   interface{} `json:"colorscale,omitempty"` // name or [position, color] pairs
+	ReverseScale *bool       `json:"reversescale,omitempty"`
+	ShowScale    *bool       `json:"showscale,omitempty"`
+	ZMin         *float64    `json:"zmin,omitempty"`
+	ZMax         *float64    `json:"zmax,omitempty"`
+	ZMid         *float64    `json:"zmid,omitempty"`
+	ZAuto        *bool       `json:"zauto,omitempty"`
+	ColorBar     *ColorBar   `json:"colorbar,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	TextTemplate  string      `json:"texttemplate,omitempty"`
+	TextFont      *Font       `json:"textfont,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	XAxis       string `json:"xaxis,omitempty"`
+	YAxis       string `json:"yaxis,omitempty"`
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// Contours represents the contour level settings of contour traces
+type Contours struct {
+	Type        string      `json:"type,omitempty"` // "levels" or "constraint"
+	Start       *float64    `json:"start,omitempty"`
+	End         *float64    `json:"end,omitempty"`
+	Size        *float64    `json:"size,omitempty"`
+	Coloring    string      `json:"coloring,omitempty"`
+	ShowLines   *bool       `json:"showlines,omitempty"`
+	ShowLabels  *bool       `json:"showlabels,omitempty"`
+	LabelFont   *Font       `json:"labelfont,omitempty"`
+	LabelFormat string      `json:"labelformat,omitempty"`
+	Operation   string      `json:"operation,omitempty"` // constraint operation, e.g. ">=" or "[]"
+	Value       interface{} `json:"value,omitempty"`     // constraint value, [lower, upper] for ranges
+}
+
+// ContourLine represents the contour line properties
+type ContourLine struct {
+	Color     string   `json:"color,omitempty"`
+	Width     float64  `json:"width,omitempty"`
+	Dash      string   `json:"dash,omitempty"`
+	Smoothing *float64 `json:"smoothing,omitempty"` // 0-1.3
+}
+
+// NewContour creates a new contour trace
+func NewContour() *Contour {
+	return &Contour{
+		BaseTrace: BaseTrace{
+			Type: "contour",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (c *Contour) Validate() error {
+	if err := c.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	rows, columns, err := validateMatrix("Z", c.Z)
+	if err != nil {
+		return err
+	}
+
+	// Labels match the matrix dimensions, or hold the edges of the cells
+	if c.Transpose != nil && *c.Transpose {
+		rows, columns = columns, rows
+	}
+	if err := validateMatrixLabels("X", c.X, columns); err != nil {
+		return err
+	}
+	if err := validateMatrixLabels("Y", c.Y, rows); err != nil {
+		return err
+	}
+
+	if err := validateContourSettings(c.Contours, c.NContours, c.Line); err != nil {
+		return err
+	}
+
+	if err := validateColorScale("ColorScale", c.ColorScale); err != nil {
+		return err
+	}
+	return validateColorRange("ZMin", c.ZMin, "ZMax", c.ZMax)
+}
+
+// validateContourSettings validates the contour levels, number of contours
+// and contour line shared by contour traces
+func validateContourSettings(contours *Contours, ncontours int, line *ContourLine) error {
+	if ncontours < 0 {
+		return &validation.ValidationError{
+			Field:   "NContours",
+			Message: "number of contours must be non-negative",
+		}
+	}
+	if contours != nil {
+		if err := contours.validate(); err != nil {
+			return err
+		}
+	}
+	if line != nil {
+		if err := line.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Contours) validate() error {
+	if c.Type != "" && c.Type != ContourTypeLevels && c.Type != ContourTypeConstraint {
+		return &validation.ValidationError{
+			Field:   "Contours.Type",
+			Message: fmt.Sprintf("invalid contour type: %s", c.Type),
+		}
+	}
+
+	validColoring := map[string]bool{
+		ContourColoringFill:    true,
+		ContourColoringHeatmap: true,
+		ContourColoringLines:   true,
+		ContourColoringNone:    true,
+	}
+	if c.Coloring != "" && !validColoring[c.Coloring] {
+		return &validation.ValidationError{
+			Field:   "Contours.Coloring",
+			Message: fmt.Sprintf("invalid contour coloring: %s", c.Coloring),
+		}
+	}
+
+	if c.Size != nil && *c.Size <= 0 {
+		return &validation.ValidationError{
+			Field:   "Contours.Size",
+			Message: "contour size must be positive",
+		}
+	}
+	if c.Start != nil && c.End != nil && *c.Start >= *c.End {
+		return &validation.ValidationError{
+			Field:   "Contours",
+			Message: "contour start must be less than end",
+		}
+	}
+
+	if c.Operation != "" {
+		comparisons := map[string]bool{"=": true, "<": true, ">=": true, ">": true, "<=": true}
+		ranges := map[string]bool{"[]": true, "()": true, "[)": true, "(]": true, "][": true, ")(": true, "](": true, ")[": true}
+		switch {
+		case comparisons[c.Operation]:
+		case ranges[c.Operation]:
+			if length, ok := arrayLength(c.Value); c.Value != nil && (!ok || length != 2) {
+				return &validation.ValidationError{
+					Field:   "Contours.Value",
+					Message: fmt.Sprintf("range operation %s requires a [lower, upper] value", c.Operation),
+				}
+			}
+		default:
+			return &validation.ValidationError{
+				Field:   "Contours.Operation",
+				Message: fmt.Sprintf("invalid contour operation: %s", c.Operation),
+			}
+		}
+	}
+	return nil
+}
+
+func (l *ContourLine) validate() error {
+	if l.Width < 0 {
+		return &validation.ValidationError{
+			Field:   "Line.Width",
+			Message: "line width must be non-negative",
+		}
+	}
+
+	validDash := map[string]bool{
+		DashSolid:       true,
+		DashDot:         true,
+		DashDash:        true,
+		DashLongDash:    true,
+		DashDashDot:     true,
+		DashLongDashDot: true,
+	}
+	if l.Dash != "" && !validDash[l.Dash] {
+		return &validation.ValidationError{
+			Field:   "Line.Dash",
+			Message: fmt.Sprintf("invalid dash pattern: %s", l.Dash),
+		}
+	}
+
+	if l.Smoothing != nil && (*l.Smoothing < 0 || *l.Smoothing > 1.3) {
+		return &validation.ValidationError{
+			Field:   "Line.Smoothing",
+			Message: "line smoothing must be between 0 and 1.3",
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, decoding gaps in
+// the z matrix as NaN
+func (c *Contour) UnmarshalJSON(data []byte) error {
+	type contour Contour
+	aux := struct {
+		*contour
+		Z [][]*float64 `json:"z"`
+	}{contour: (*contour)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	c.Z = matrixFromJSON(aux.Z)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (c *Contour) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&c.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and data
+	m["type"] = "contour"
+	m["z"] = matrixJSON(c.Z)
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("x", c.X)
+	addIfNotEmpty("y", c.Y)
+
+	// Contour Properties
+	if c.Contours != nil {
+		m["contours"] = c.Contours
+	}
+	if c.NContours != 0 {
+		m["ncontours"] = c.NContours
+	}
+	if c.AutoContour != nil {
+		m["autocontour"] = *c.AutoContour
+	}
+	if c.Line != nil {
+		m["line"] = c.Line
+	}
+	if c.FillColor != "" {
+		m["fillcolor"] = c.FillColor
+	}
+	if c.ConnectGaps != nil {
+		m["connectgaps"] = *c.ConnectGaps
+	}
+	if c.HoverOnGaps != nil {
+		m["hoverongaps"] = *c.HoverOnGaps
+	}
+	if c.Transpose != nil {
+		m["transpose"] = *c.Transpose
+	}
+
+	// Color Properties
+	addIfNotEmpty("colorscale", c.ColorScale)
+	if c.ReverseScale != nil {
+		m["reversescale"] = *c.ReverseScale
+	}
+	if c.ShowScale != nil {
+		m["showscale"] = *c.ShowScale
+	}
+	if c.ZMin != nil {
+		m["zmin"] = *c.ZMin
+	}
+	if c.ZMax != nil {
+		m["zmax"] = *c.ZMax
+	}
+	if c.ZMid != nil {
+		m["zmid"] = *c.ZMid
+	}
+	if c.ZAuto != nil {
+		m["zauto"] = *c.ZAuto
+	}
+	if c.ColorBar != nil {
+		m["colorbar"] = c.ColorBar
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", c.Text)
+	if c.TextTemplate != "" {
+		m["texttemplate"] = c.TextTemplate
+	}
+	if c.TextFont != nil {
+		m["textfont"] = c.TextFont
+	}
+	addIfNotEmpty("hovertext", c.HoverText)
+	if c.HoverTemplate != "" {
+		m["hovertemplate"] = c.HoverTemplate
+	}
+	if c.HoverLabel != nil {
+		m["hoverlabel"] = c.HoverLabel
+	}
+
+	// Layout Properties
+	if c.XAxis != "" {
+		m["xaxis"] = c.XAxis
+	}
+	if c.YAxis != "" {
+		m["yaxis"] = c.YAxis
+	}
+	if c.LegendGroup != "" {
+		m["legendgroup"] = c.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,171 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContourValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Contour)
+		expectedError string
+	}{
+		{
+			name:          "valid contour",
+			setup:         func(c *Contour) {},
+			expectedError: "",
+		},
+		{
+			name: "ragged z",
+			setup: func(c *Contour) {
+				c.Z = [][]float64{{1, 2, 3}, {4}}
+			},
+			expectedError: "z matrix must be rectangular",
+		},
+		{
+			name: "x labels do not match columns",
+			setup: func(c *Contour) {
+				c.X = []float64{1, 2, 3, 4, 5}
+			},
+			expectedError: "5 labels do not match the 3 cells",
+		},
+		{
+			name: "invalid coloring",
+			setup: func(c *Contour) {
+				c.Contours = &Contours{Coloring: "gradient"}
+			},
+			expectedError: "invalid contour coloring: gradient",
+		},
+		{
+			name: "invalid contour type",
+			setup: func(c *Contour) {
+				c.Contours = &Contours{Type: "bands"}
+			},
+			expectedError: "invalid contour type: bands",
+		},
+		{
+			name: "non-positive contour size",
+			setup: func(c *Contour) {
+				c.Contours = &Contours{Size: Float64(0)}
+			},
+			expectedError: "contour size must be positive",
+		},
+		{
+			name: "start above end",
+			setup: func(c *Contour) {
+				c.Contours = &Contours{Start: Float64(10), End: Float64(2), Size: Float64(1)}
+			},
+			expectedError: "contour start must be less than end",
+		},
+		{
+			name: "levels with labels",
+			setup: func(c *Contour) {
+				c.Contours = &Contours{
+					Start:       Float64(0),
+					End:         Float64(6),
+					Size:        Float64(0.5),
+					Coloring:    ContourColoringHeatmap,
+					ShowLabels:  Bool(true),
+					LabelFormat: ".1f",
+				}
+			},
+			expectedError: "",
+		},
+		{
+			name: "constraint range",
+			setup: func(c *Contour) {
+				c.Contours = &Contours{Type: ContourTypeConstraint, Operation: "[]", Value: []float64{2, 4}}
+			},
+			expectedError: "",
+		},
+		{
+			name: "constraint range with a single value",
+			setup: func(c *Contour) {
+				c.Contours = &Contours{Type: ContourTypeConstraint, Operation: "[]", Value: 2}
+			},
+			expectedError: "requires a [lower, upper] value",
+		},
+		{
+			name: "invalid operation",
+			setup: func(c *Contour) {
+				c.Contours = &Contours{Type: ContourTypeConstraint, Operation: "!="}
+			},
+			expectedError: "invalid contour operation: !=",
+		},
+		{
+			name: "negative ncontours",
+			setup: func(c *Contour) {
+				c.NContours = -1
+			},
+			expectedError: "number of contours must be non-negative",
+		},
+		{
+			name: "smoothing above 1.3",
+			setup: func(c *Contour) {
+				c.Line = &ContourLine{Smoothing: Float64(2)}
+			},
+			expectedError: "line smoothing must be between 0 and 1.3",
+		},
+		{
+			name: "invalid dash",
+			setup: func(c *Contour) {
+				c.Line = &ContourLine{Dash: "wavy"}
+			},
+			expectedError: "invalid dash pattern: wavy",
+		},
+		{
+			name: "invalid colorscale",
+			setup: func(c *Contour) {
+				c.ColorScale = [][]interface{}{{0, "white"}, {0.5, "red"}}
+			},
+			expectedError: "must start at 0 and end at 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewContour()
+			c.Z = [][]float64{{1, 2, 3}, {4, 5, 6}}
+			c.X = []float64{-1, 0, 1}
+			c.Y = []float64{0, 1}
+			tt.setup(c)
+
+			err := c.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestContourMarshalJSON(t *testing.T) {
+	c := NewContour()
+	c.Z = [][]float64{{1, 2}, {math.NaN(), 4}}
+	c.Contours = &Contours{Start: Float64(0), End: Float64(4), Size: Float64(1), ShowLabels: Bool(true)}
+	c.Line = &ContourLine{Width: 1, Smoothing: Float64(0)}
+	c.ColorScale = ColorScaleViridis
+
+	data, err := json.Marshal(c)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"contour"`)
+	assert.Contains(t, jsonStr, `"z":[[1,2],[null,4]]`)
+	assert.Contains(t, jsonStr, `"contours":{"start":0,"end":4,"size":1,"showlabels":true}`)
+	assert.Contains(t, jsonStr, `"line":{"width":1,"smoothing":0}`)
+	assert.Contains(t, jsonStr, `"colorscale":"Viridis"`)
+
+	decoded, err := DecodeTrace(data)
+	assert.NoError(t, err)
+	contour, ok := decoded.(*Contour)
+	assert.True(t, ok)
+	assert.True(t, math.IsNaN(contour.Z[1][0]))
+	assert.Equal(t, 4.0, *contour.Contours.End)
+}
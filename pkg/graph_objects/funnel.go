@@ -0,0 +1,190 @@
+package graph_objects
+
+import (
+	"encoding/json"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Funnel text info flags, combined with "+", e.g. "value+percent initial"
+const (
+	FunnelTextInfoLabel           = "label"
+	FunnelTextInfoText            = "text"
+	FunnelTextInfoValue           = "value"
+	FunnelTextInfoPercentInitial  = "percent initial"
+	FunnelTextInfoPercentPrevious = "percent previous"
+	FunnelTextInfoPercentTotal    = "percent total"
+)
+
+// Funnel represents a funnel trace, which draws the stages of a process as
+// centered bars, for example the steps of a conversion funnel
+type Funnel struct {
+	BaseTrace
+	// Data
+	X              interface{} `json:"x,omitempty"`
+	Y              interface{} `json:"y,omitempty"`
+	Orientation    string      `json:"orientation,omitempty"` // defaults to "h" in plotly
+	Width          float64     `json:"width,omitempty"`
+	Offset         float64     `json:"offset,omitempty"`
+	OffsetGroup    string      `json:"offsetgroup,omitempty"`
+	AlignmentGroup string      `json:"alignmentgroup,omitempty"`
+
+	// Styling
+	Marker    *BarMarker `json:"marker,omitempty"`
+	Connector *Connector `json:"connector,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	TextInfo      string      `json:"textinfo,omitempty"`     // flags such as "value+percent initial", or "none"
+	TextPosition  interface{} `json:"textposition,omitempty"` // string or array
+	TextTemplate  string      `json:"texttemplate,omitempty"`
+	TextFont      *Font       `json:"textfont,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	XAxis       string `json:"xaxis,omitempty"`
+	YAxis       string `json:"yaxis,omitempty"`
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// NewFunnel creates a new funnel trace
+func NewFunnel() *Funnel {
+	return &Funnel{
+		BaseTrace: BaseTrace{
+			Type: "funnel",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (f *Funnel) Validate() error {
+	if err := f.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateBarOrientation(f.Orientation); err != nil {
+		return err
+	}
+
+	// Validate that X or Y is present
+	if f.X == nil && f.Y == nil {
+		return &validation.ValidationError{
+			Field:   "X/Y",
+			Message: "at least one of X or Y must be provided",
+		}
+	}
+
+	if f.Width < 0 {
+		return &validation.ValidationError{
+			Field:   "Width",
+			Message: "width must be non-negative",
+		}
+	}
+
+	if f.Connector != nil {
+		if err := f.Connector.validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate text info and position
+	if f.TextInfo != "" && f.TextInfo != "none" {
+		validFlags := map[string]bool{
+			FunnelTextInfoLabel:           true,
+			FunnelTextInfoText:            true,
+			FunnelTextInfoValue:           true,
+			FunnelTextInfoPercentInitial:  true,
+			FunnelTextInfoPercentPrevious: true,
+			FunnelTextInfoPercentTotal:    true,
+		}
+		if err := validateFlagList("TextInfo", "textinfo flag", f.TextInfo, validFlags); err != nil {
+			return err
+		}
+	}
+	return validateTextPositions(f.TextPosition)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (f *Funnel) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&f.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type
+	m["type"] = "funnel"
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("x", f.X)
+	addIfNotEmpty("y", f.Y)
+	if f.Orientation != "" {
+		m["orientation"] = f.Orientation
+	}
+	if f.Width != 0 {
+		m["width"] = f.Width
+	}
+	if f.Offset != 0 {
+		m["offset"] = f.Offset
+	}
+	if f.OffsetGroup != "" {
+		m["offsetgroup"] = f.OffsetGroup
+	}
+	if f.AlignmentGroup != "" {
+		m["alignmentgroup"] = f.AlignmentGroup
+	}
+
+	// Styling
+	if f.Marker != nil {
+		m["marker"] = f.Marker
+	}
+	if f.Connector != nil {
+		m["connector"] = f.Connector
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", f.Text)
+	if f.TextInfo != "" {
+		m["textinfo"] = f.TextInfo
+	}
+	addIfNotEmpty("textposition", f.TextPosition)
+	if f.TextTemplate != "" {
+		m["texttemplate"] = f.TextTemplate
+	}
+	if f.TextFont != nil {
+		m["textfont"] = f.TextFont
+	}
+	addIfNotEmpty("hovertext", f.HoverText)
+	if f.HoverTemplate != "" {
+		m["hovertemplate"] = f.HoverTemplate
+	}
+	if f.HoverLabel != nil {
+		m["hoverlabel"] = f.HoverLabel
+	}
+
+	// Layout Properties
+	if f.XAxis != "" {
+		m["xaxis"] = f.XAxis
+	}
+	if f.YAxis != "" {
+		m["yaxis"] = f.YAxis
+	}
+	if f.LegendGroup != "" {
+		m["legendgroup"] = f.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
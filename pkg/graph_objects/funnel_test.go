@@ -0,0 +1,102 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFunnelValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Funnel)
+		expectedError string
+	}{
+		{
+			name:          "valid funnel",
+			setup:         func(f *Funnel) {},
+			expectedError: "",
+		},
+		{
+			name: "missing x and y",
+			setup: func(f *Funnel) {
+				f.X = nil
+				f.Y = nil
+			},
+			expectedError: "at least one of X or Y must be provided",
+		},
+		{
+			name: "invalid orientation",
+			setup: func(f *Funnel) {
+				f.Orientation = "horizontal"
+			},
+			expectedError: "invalid orientation: horizontal",
+		},
+		{
+			name: "percent text info",
+			setup: func(f *Funnel) {
+				f.TextInfo = "value+percent initial+percent previous"
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid text info flag",
+			setup: func(f *Funnel) {
+				f.TextInfo = "value+percent"
+			},
+			expectedError: "invalid textinfo flag: percent",
+		},
+		{
+			name: "negative connector width",
+			setup: func(f *Funnel) {
+				f.Connector = &Connector{Line: &ConnectorLine{Width: -1}}
+			},
+			expectedError: "line width must be non-negative",
+		},
+		{
+			name: "negative width",
+			setup: func(f *Funnel) {
+				f.Width = -0.5
+			},
+			expectedError: "width must be non-negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFunnel()
+			f.X = []float64{1200, 640, 210, 85}
+			f.Y = []string{"Visit", "Sign up", "Trial", "Purchase"}
+			tt.setup(f)
+
+			err := f.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestFunnelMarshalJSON(t *testing.T) {
+	f := NewFunnel()
+	f.X = []float64{1200, 640}
+	f.Y = []string{"Visit", "Sign up"}
+	f.TextInfo = "value+percent initial"
+	f.Marker = &BarMarker{Color: []string{"#1f77b4", "#ff7f0e"}}
+	f.Connector = &Connector{FillColor: "#eeeeee"}
+
+	data, err := json.Marshal(f)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"funnel"`)
+	assert.Contains(t, jsonStr, `"x":[1200,640]`)
+	assert.Contains(t, jsonStr, `"textinfo":"value+percent initial"`)
+	assert.Contains(t, jsonStr, `"marker":{"color":["#1f77b4","#ff7f0e"]}`)
+	assert.Contains(t, jsonStr, `"connector":{"fillcolor":"#eeeeee"}`)
+	assert.NotContains(t, jsonStr, `"orientation"`)
+}
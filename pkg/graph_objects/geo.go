@@ -0,0 +1,268 @@
+package graph_objects
+
+import (
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Geo scopes, the regions of the world a geo subplot can be limited to
+const (
+	GeoScopeWorld        = "world"
+	GeoScopeUSA          = "usa"
+	GeoScopeEurope       = "europe"
+	GeoScopeAsia         = "asia"
+	GeoScopeAfrica       = "africa"
+	GeoScopeNorthAmerica = "north america"
+	GeoScopeSouthAmerica = "south america"
+)
+
+// Common geo projection types. Plotly supports many more, see
+// validProjections.
+const (
+	GeoProjectionEquirectangular = "equirectangular"
+	GeoProjectionMercator        = "mercator"
+	GeoProjectionOrthographic    = "orthographic"
+	GeoProjectionNaturalEarth    = "natural earth"
+	GeoProjectionRobinson        = "robinson"
+	GeoProjectionMollweide       = "mollweide"
+	GeoProjectionAlbersUSA       = "albers usa"
+	GeoProjectionWinkelTripel    = "winkel tripel"
+)
+
+// Fit bounds of a geo subplot
+const (
+	FitBoundsLocations = "locations"
+	FitBoundsGeoJSON   = "geojson"
+)
+
+// validProjections holds the projection types supported by plotly.js
+var validProjections = map[string]bool{
+	"airy": true, "aitoff": true, "albers": true, "albers usa": true, "august": true,
+	"azimuthal equal area": true, "azimuthal equidistant": true, "baker": true,
+	"bertin1953": true, "boggs": true, "bonne": true, "bottomley": true, "bromley": true,
+	"collignon": true, "conic conformal": true, "conic equal area": true,
+	"conic equidistant": true, "craig": true, "craster": true,
+	"cylindrical equal area": true, "cylindrical stereographic": true,
+	"eckert1": true, "eckert2": true, "eckert3": true, "eckert4": true, "eckert5": true,
+	"eckert6": true, "eisenlohr": true, "equal earth": true, "equirectangular": true,
+	"fahey": true, "foucaut": true, "foucaut sinusoidal": true, "ginzburg4": true,
+	"ginzburg5": true, "ginzburg6": true, "ginzburg8": true, "ginzburg9": true,
+	"gnomonic": true, "gringorten": true, "gringorten quincuncial": true, "guyou": true,
+	"hammer": true, "hill": true, "homolosine": true, "hufnagel": true,
+	"hyperelliptical": true, "kavrayskiy7": true, "lagrange": true, "larrivee": true,
+	"laskowski": true, "loximuthal": true, "mercator": true, "miller": true,
+	"mollweide": true, "mt flat polar parabolic": true, "mt flat polar quartic": true,
+	"mt flat polar sinusoidal": true, "natural earth": true, "natural earth1": true,
+	"natural earth2": true, "nell hammer": true, "nicolosi": true, "orthographic": true,
+	"patterson": true, "peirce quincuncial": true, "polyconic": true,
+	"rectangular polyconic": true, "robinson": true, "satellite": true,
+	"sinu mollweide": true, "sinusoidal": true, "stereographic": true, "times": true,
+	"transverse mercator": true, "van der grinten": true, "van der grinten2": true,
+	"van der grinten3": true, "van der grinten4": true, "wagner4": true, "wagner6": true,
+	"wiechel": true, "winkel tripel": true, "winkel3": true,
+}
+
+// Geo represents the geographic map that Scattergeo and Choropleth traces are
+// drawn in. Traces refer to additional maps such as "geo2", which can be set
+// through Layout.Extra.
+type Geo struct {
+	Scope      string         `json:"scope,omitempty"` // e.g. "world", "usa" or "europe"
+	Projection *GeoProjection `json:"projection,omitempty"`
+	Center     *GeoCenter     `json:"center,omitempty"`
+	FitBounds  interface{}    `json:"fitbounds,omitempty"`  // false, "locations" or "geojson"
+	Resolution int            `json:"resolution,omitempty"` // 110 or 50, the scale of the base map in millions
+	LonAxis    *GeoAxis       `json:"lonaxis,omitempty"`
+	LatAxis    *GeoAxis       `json:"lataxis,omitempty"`
+	Domain     *Domain        `json:"domain,omitempty"`
+	Visible    *bool          `json:"visible,omitempty"` // false hides the base map
+
+	// Base Map Layers
+	ShowLand       *bool   `json:"showland,omitempty"`
+	LandColor      string  `json:"landcolor,omitempty"`
+	ShowOcean      *bool   `json:"showocean,omitempty"`
+	OceanColor     string  `json:"oceancolor,omitempty"`
+	ShowLakes      *bool   `json:"showlakes,omitempty"`
+	LakeColor      string  `json:"lakecolor,omitempty"`
+	ShowRivers     *bool   `json:"showrivers,omitempty"`
+	RiverColor     string  `json:"rivercolor,omitempty"`
+	ShowCountries  *bool   `json:"showcountries,omitempty"`
+	CountryColor   string  `json:"countrycolor,omitempty"`
+	CountryWidth   float64 `json:"countrywidth,omitempty"`
+	ShowSubunits   *bool   `json:"showsubunits,omitempty"` // e.g. US states
+	SubunitColor   string  `json:"subunitcolor,omitempty"`
+	ShowCoastlines *bool   `json:"showcoastlines,omitempty"`
+	CoastlineColor string  `json:"coastlinecolor,omitempty"`
+	CoastlineWidth float64 `json:"coastlinewidth,omitempty"`
+	ShowFrame      *bool   `json:"showframe,omitempty"`
+	FrameColor     string  `json:"framecolor,omitempty"`
+	BgColor        string  `json:"bgcolor,omitempty"`
+}
+
+// GeoProjection represents the map projection of a geo subplot
+type GeoProjection struct {
+	Type     string       `json:"type,omitempty"`
+	Rotation *GeoRotation `json:"rotation,omitempty"`
+	Scale    float64      `json:"scale,omitempty"` // zoom, 1 shows the whole scope
+}
+
+// GeoRotation represents the rotation of a map projection, in degrees
+type GeoRotation struct {
+	Lon  *float64 `json:"lon,omitempty"`
+	Lat  *float64 `json:"lat,omitempty"`
+	Roll *float64 `json:"roll,omitempty"`
+}
+
+// GeoCenter represents the point a geo subplot is centered on
+type GeoCenter struct {
+	Lon *float64 `json:"lon,omitempty"`
+	Lat *float64 `json:"lat,omitempty"`
+}
+
+// GeoAxis represents the longitude or latitude axis of a geo subplot
+type GeoAxis struct {
+	Range     []float64 `json:"range,omitempty"` // [min, max] in degrees
+	ShowGrid  *bool     `json:"showgrid,omitempty"`
+	GridColor string    `json:"gridcolor,omitempty"`
+	GridWidth float64   `json:"gridwidth,omitempty"`
+	DTick     float64   `json:"dtick,omitempty"`
+}
+
+// validate checks the scope, projection, bounds and axes of a geo subplot
+func (g *Geo) validate(field string) error {
+	if g.Scope != "" {
+		validScopes := map[string]bool{
+			GeoScopeWorld:        true,
+			GeoScopeUSA:          true,
+			GeoScopeEurope:       true,
+			GeoScopeAsia:         true,
+			GeoScopeAfrica:       true,
+			GeoScopeNorthAmerica: true,
+			GeoScopeSouthAmerica: true,
+		}
+		if !validScopes[g.Scope] {
+			return &validation.ValidationError{
+				Field:   field + ".Scope",
+				Message: fmt.Sprintf("invalid geo scope: %s", g.Scope),
+			}
+		}
+	}
+
+	if p := g.Projection; p != nil {
+		if p.Type != "" && !validProjections[p.Type] {
+			return &validation.ValidationError{
+				Field:   field + ".Projection.Type",
+				Message: fmt.Sprintf("invalid projection type: %s", p.Type),
+			}
+		}
+		if p.Scale < 0 {
+			return &validation.ValidationError{
+				Field:   field + ".Projection.Scale",
+				Message: "projection scale must be non-negative",
+			}
+		}
+	}
+
+	if g.Center != nil {
+		if err := validateLonLat(field+".Center", g.Center.Lon, g.Center.Lat); err != nil {
+			return err
+		}
+	}
+
+	switch v := g.FitBounds.(type) {
+	case nil:
+	case bool:
+		if v {
+			return &validation.ValidationError{
+				Field:   field + ".FitBounds",
+				Message: "fit bounds can only be disabled with false",
+			}
+		}
+	case string:
+		if v != FitBoundsLocations && v != FitBoundsGeoJSON {
+			return &validation.ValidationError{
+				Field:   field + ".FitBounds",
+				Message: fmt.Sprintf("invalid fit bounds: %s", v),
+			}
+		}
+	default:
+		return &validation.ValidationError{
+			Field:   field + ".FitBounds",
+			Message: "fit bounds must be a string or false",
+		}
+	}
+
+	if g.Resolution != 0 && g.Resolution != 110 && g.Resolution != 50 {
+		return &validation.ValidationError{
+			Field:   field + ".Resolution",
+			Message: fmt.Sprintf("resolution must be 110 or 50, got %d", g.Resolution),
+		}
+	}
+
+	axes := []struct {
+		field string
+		axis  *GeoAxis
+	}{
+		{field + ".LonAxis", g.LonAxis},
+		{field + ".LatAxis", g.LatAxis},
+	}
+	for _, a := range axes {
+		if a.axis == nil {
+			continue
+		}
+		if a.axis.Range != nil && (len(a.axis.Range) != 2 || a.axis.Range[0] >= a.axis.Range[1]) {
+			return &validation.ValidationError{
+				Field:   a.field + ".Range",
+				Message: "range must be a [min, max] range of degrees",
+			}
+		}
+		if err := validateAxisLines(a.field, a.axis.GridWidth, 0, 0); err != nil {
+			return err
+		}
+		if a.axis.DTick < 0 {
+			return &validation.ValidationError{
+				Field:   a.field + ".DTick",
+				Message: "tick spacing must be non-negative",
+			}
+		}
+	}
+
+	widths := []struct {
+		field string
+		value float64
+	}{
+		{field + ".CountryWidth", g.CountryWidth},
+		{field + ".CoastlineWidth", g.CoastlineWidth},
+	}
+	for _, w := range widths {
+		if w.value < 0 {
+			return &validation.ValidationError{
+				Field:   w.field,
+				Message: "line width must be non-negative",
+			}
+		}
+	}
+
+	if g.Domain != nil {
+		return g.Domain.validate()
+	}
+	return nil
+}
+
+// validateLonLat checks that a longitude is in [-180, 180] and a latitude in
+// [-90, 90]
+func validateLonLat(field string, lon, lat *float64) error {
+	if lon != nil && (*lon < -180 || *lon > 180) {
+		return &validation.ValidationError{
+			Field:   field + ".Lon",
+			Message: fmt.Sprintf("longitude %g must be between -180 and 180", *lon),
+		}
+	}
+	if lat != nil && (*lat < -90 || *lat > 90) {
+		return &validation.ValidationError{
+			Field:   field + ".Lat",
+			Message: fmt.Sprintf("latitude %g must be between -90 and 90", *lat),
+		}
+	}
+	return nil
+}
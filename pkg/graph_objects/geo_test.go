@@ -0,0 +1,107 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeoValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		geo           *Geo
+		expectedError string
+	}{
+		{
+			name: "valid geo",
+			geo: &Geo{
+				Scope:      GeoScopeEurope,
+				Projection: &GeoProjection{Type: GeoProjectionMercator, Scale: 1.5},
+				Center:     &GeoCenter{Lon: Float64(10), Lat: Float64(50)},
+				FitBounds:  FitBoundsLocations,
+				Resolution: 50,
+				ShowLand:   Bool(true),
+				LandColor:  "#e5ecf6",
+			},
+			expectedError: "",
+		},
+		{
+			name:          "fit bounds disabled",
+			geo:           &Geo{FitBounds: false},
+			expectedError: "",
+		},
+		{
+			name:          "invalid scope",
+			geo:           &Geo{Scope: "oceania"},
+			expectedError: "invalid geo scope: oceania",
+		},
+		{
+			name:          "invalid projection",
+			geo:           &Geo{Projection: &GeoProjection{Type: "flat"}},
+			expectedError: "invalid projection type: flat",
+		},
+		{
+			name:          "negative projection scale",
+			geo:           &Geo{Projection: &GeoProjection{Scale: -1}},
+			expectedError: "projection scale must be non-negative",
+		},
+		{
+			name:          "latitude out of range",
+			geo:           &Geo{Center: &GeoCenter{Lat: Float64(95)}},
+			expectedError: "latitude 95 must be between -90 and 90",
+		},
+		{
+			name:          "invalid fit bounds",
+			geo:           &Geo{FitBounds: "world"},
+			expectedError: "invalid fit bounds: world",
+		},
+		{
+			name:          "fit bounds true",
+			geo:           &Geo{FitBounds: true},
+			expectedError: "fit bounds can only be disabled with false",
+		},
+		{
+			name:          "invalid resolution",
+			geo:           &Geo{Resolution: 10},
+			expectedError: "resolution must be 110 or 50, got 10",
+		},
+		{
+			name:          "invalid axis range",
+			geo:           &Geo{LatAxis: &GeoAxis{Range: []float64{60, 30}}},
+			expectedError: "range must be a [min, max] range of degrees",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Layout{Geo: tt.geo}).Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestGeoMarshalJSON(t *testing.T) {
+	layout := &Layout{
+		Geo: &Geo{
+			Scope:      GeoScopeUSA,
+			Projection: &GeoProjection{Type: GeoProjectionAlbersUSA},
+			ShowLand:   Bool(true),
+		},
+	}
+
+	data, err := json.Marshal(layout)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"geo":{"scope":"usa","projection":{"type":"albers usa"},"showland":true}`)
+
+	// Geographic maps decode into the typed field rather than Extra
+	var decoded Layout
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, GeoScopeUSA, decoded.Geo.Scope)
+	assert.Empty(t, decoded.Extra)
+}
@@ -0,0 +1,205 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Location modes of geographic traces
+const (
+	LocationModeISO3         = "ISO-3"
+	LocationModeUSAStates    = "USA-states"
+	LocationModeCountryNames = "country names"
+	LocationModeGeoJSONID    = "geojson-id"
+)
+
+// GeoJSON represents a GeoJSON FeatureCollection supplied to Scattergeo and
+// Choropleth traces. Geometries are kept as raw JSON.
+type GeoJSON struct {
+	Type     string            `json:"type"`
+	Features []*GeoJSONFeature `json:"features"`
+}
+
+// GeoJSONFeature represents a feature of a GeoJSON document
+type GeoJSONFeature struct {
+	Type       string                 `json:"type"`
+	ID         interface{}            `json:"id,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+	Geometry   json.RawMessage        `json:"geometry"`
+}
+
+// LoadGeoJSON reads a GeoJSON FeatureCollection or Feature from a file. The
+// document is only read from disk; it is never fetched over the network.
+func LoadGeoJSON(path string) (*GeoJSON, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading GeoJSON: %v", err)
+	}
+	geojson, err := ParseGeoJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing GeoJSON %s: %v", path, err)
+	}
+	return geojson, nil
+}
+
+// ParseGeoJSON parses a GeoJSON FeatureCollection or Feature. A single
+// Feature is returned as a FeatureCollection holding it.
+func ParseGeoJSON(data []byte) (*GeoJSON, error) {
+	var doc struct {
+		Type     string            `json:"type"`
+		Features []*GeoJSONFeature `json:"features"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	switch doc.Type {
+	case "FeatureCollection":
+		for i, feature := range doc.Features {
+			if feature == nil || feature.Type != "Feature" {
+				return nil, fmt.Errorf("element %d of features is not a Feature", i)
+			}
+		}
+		return &GeoJSON{Type: doc.Type, Features: doc.Features}, nil
+	case "Feature":
+		var feature GeoJSONFeature
+		if err := json.Unmarshal(data, &feature); err != nil {
+			return nil, err
+		}
+		return &GeoJSON{Type: "FeatureCollection", Features: []*GeoJSONFeature{&feature}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported GeoJSON type %q, expected FeatureCollection or Feature", doc.Type)
+	}
+}
+
+// FeatureIDs returns the IDs of the features found with a feature ID key,
+// "id" or a path into the feature properties such as "properties.name".
+// Features without an ID are skipped.
+func (g *GeoJSON) FeatureIDs(featureIDKey string) []string {
+	ids := make([]string, 0, len(g.Features))
+	for _, feature := range g.Features {
+		if id, ok := feature.id(featureIDKey); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// id returns the ID of the feature found with a feature ID key
+func (f *GeoJSONFeature) id(featureIDKey string) (string, bool) {
+	var value interface{}
+	if featureIDKey == "" || featureIDKey == "id" {
+		value = f.ID
+	} else {
+		value = f.Properties
+		for _, key := range strings.Split(strings.TrimPrefix(featureIDKey, "properties."), ".") {
+			properties, ok := value.(map[string]interface{})
+			if !ok {
+				return "", false
+			}
+			value = properties[key]
+		}
+	}
+
+	switch id := value.(type) {
+	case nil, map[string]interface{}, []interface{}:
+		return "", false
+	case string:
+		return id, true
+	default:
+		return fmt.Sprint(id), true
+	}
+}
+
+// validateGeoLocations checks the location mode, GeoJSON and feature ID key
+// of a geographic trace. With a GeoJSON document the locations must all be
+// feature IDs of the document. GeoJSON given as a URL is not fetched and its
+// feature IDs are not checked.
+func validateGeoLocations(locations []string, locationMode string, geojson interface{}, featureIDKey string) error {
+	if locationMode != "" {
+		validModes := map[string]bool{
+			LocationModeISO3:         true,
+			LocationModeUSAStates:    true,
+			LocationModeCountryNames: true,
+			LocationModeGeoJSONID:    true,
+		}
+		if !validModes[locationMode] {
+			return &validation.ValidationError{
+				Field:   "LocationMode",
+				Message: fmt.Sprintf("invalid location mode: %s", locationMode),
+			}
+		}
+	}
+
+	if featureIDKey != "" && featureIDKey != "id" && !strings.HasPrefix(featureIDKey, "properties.") {
+		return &validation.ValidationError{
+			Field:   "FeatureIDKey",
+			Message: fmt.Sprintf("feature ID key must be \"id\" or start with \"properties.\", got %s", featureIDKey),
+		}
+	}
+
+	if geojson == nil {
+		if locationMode == LocationModeGeoJSONID {
+			return &validation.ValidationError{
+				Field:   "GeoJSON",
+				Message: "GeoJSON must be provided with the geojson-id location mode",
+			}
+		}
+		return nil
+	}
+	if locationMode != "" && locationMode != LocationModeGeoJSONID {
+		return &validation.ValidationError{
+			Field:   "LocationMode",
+			Message: fmt.Sprintf("GeoJSON is only used with the geojson-id location mode, got %s", locationMode),
+		}
+	}
+
+	var doc *GeoJSON
+	switch g := geojson.(type) {
+	case string:
+		// A URL loaded by plotly.js in the browser
+		return nil
+	case *GeoJSON:
+		if g == nil {
+			return &validation.ValidationError{
+				Field:   "GeoJSON",
+				Message: "GeoJSON cannot be a nil document",
+			}
+		}
+		doc = g
+	default:
+		// GeoJSON decoded from JSON, e.g. by DecodeTrace
+		data, err := json.Marshal(g)
+		if err == nil {
+			doc, err = ParseGeoJSON(data)
+		}
+		if err != nil {
+			return &validation.ValidationError{
+				Field:   "GeoJSON",
+				Message: fmt.Sprintf("invalid GeoJSON: %v", err),
+			}
+		}
+	}
+
+	ids := make(map[string]bool)
+	for _, id := range doc.FeatureIDs(featureIDKey) {
+		ids[id] = true
+	}
+	for i, location := range locations {
+		if !ids[location] {
+			key := featureIDKey
+			if key == "" {
+				key = "id"
+			}
+			return &validation.ValidationError{
+				Field:   "Locations",
+				Message: fmt.Sprintf("location %q at index %d does not match the %s of any GeoJSON feature", location, i, key),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testRegionsGeoJSON = `{
+	"type": "FeatureCollection",
+	"features": [
+		{"type": "Feature", "id": "eu-west", "properties": {"name": "EU West", "code": 1}, "geometry": {"type": "Polygon", "coordinates": [[[0, 45], [10, 45], [10, 55], [0, 55], [0, 45]]]}},
+		{"type": "Feature", "id": "eu-north", "properties": {"name": "EU North", "code": 2}, "geometry": {"type": "Polygon", "coordinates": [[[10, 55], [25, 55], [25, 65], [10, 65], [10, 55]]]}}
+	]
+}`
+
+func TestParseGeoJSON(t *testing.T) {
+	geojson, err := ParseGeoJSON([]byte(testRegionsGeoJSON))
+	assert.NoError(t, err)
+	assert.Len(t, geojson.Features, 2)
+	assert.Equal(t, []string{"eu-west", "eu-north"}, geojson.FeatureIDs("id"))
+	assert.Equal(t, []string{"eu-west", "eu-north"}, geojson.FeatureIDs(""))
+	assert.Equal(t, []string{"EU West", "EU North"}, geojson.FeatureIDs("properties.name"))
+	assert.Equal(t, []string{"1", "2"}, geojson.FeatureIDs("properties.code"))
+	assert.Empty(t, geojson.FeatureIDs("properties.missing"))
+
+	// Geometries are kept as they are
+	data, err := json.Marshal(geojson)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"coordinates":[[[0,45],[10,45],[10,55],[0,55],[0,45]]]`)
+
+	// A single feature is wrapped in a feature collection
+	feature, err := ParseGeoJSON([]byte(`{"type": "Feature", "id": 7, "properties": {}, "geometry": null}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "FeatureCollection", feature.Type)
+	assert.Equal(t, []string{"7"}, feature.FeatureIDs("id"))
+
+	_, err = ParseGeoJSON([]byte(`{"type": "Polygon", "coordinates": []}`))
+	assert.EqualError(t, err, `unsupported GeoJSON type "Polygon", expected FeatureCollection or Feature`)
+
+	_, err = ParseGeoJSON([]byte(`{"type": "FeatureCollection", "features": [{"type": "Point"}]}`))
+	assert.EqualError(t, err, "element 0 of features is not a Feature")
+}
+
+func TestLoadGeoJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "regions.geojson")
+	assert.NoError(t, os.WriteFile(path, []byte(testRegionsGeoJSON), 0o644))
+
+	geojson, err := LoadGeoJSON(path)
+	assert.NoError(t, err)
+	assert.Len(t, geojson.Features, 2)
+
+	_, err = LoadGeoJSON(filepath.Join(t.TempDir(), "missing.geojson"))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error reading GeoJSON")
+
+	invalid := filepath.Join(t.TempDir(), "invalid.geojson")
+	assert.NoError(t, os.WriteFile(invalid, []byte(`{"type": `), 0o644))
+	_, err = LoadGeoJSON(invalid)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "error parsing GeoJSON")
+}
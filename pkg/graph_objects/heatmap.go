@@ -0,0 +1,323 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Heatmap smoothing algorithms
+const (
+	ZSmoothFast = "fast"
+	ZSmoothBest = "best"
+)
+
+// Heatmap represents a heatmap trace
+type Heatmap struct {
+	BaseTrace
+	// Data
+	Z [][]float64 `json:"z"`           // rows of values, NaN for gaps
+	X interface{} `json:"x,omitempty"` // column labels or coordinates
+	Y interface{} `json:"y,omitempty"` // row labels or coordinates
+
+	// Color Properties
+	ColorScale   interface{} `json:"colorscale,omitempty"` // name or [position, color] pairs
+	ReverseScale *bool       `json:"reversescale,omitempty"`
+	ShowScale    *bool       `json:"showscale,omitempty"`
+	ZMin         *float64    `json:"zmin,omitempty"`
+	ZMax         *float64    `json:"zmax,omitempty"`
+	ZMid         *float64    `json:"zmid,omitempty"`
+	ZAuto        *bool       `json:"zauto,omitempty"`
+	ColorBar     *ColorBar   `json:"colorbar,omitempty"`
+
+	// Gap and Smoothing Properties
+	XGap        float64     `json:"xgap,omitempty"`
+	YGap        float64     `json:"ygap,omitempty"`
+	ZSmooth     interface{} `json:"zsmooth,omitempty"` // "fast", "best" or false
+	ConnectGaps *bool       `json:"connectgaps,omitempty"`
+	HoverOnGaps *bool       `json:"hoverongaps,omitempty"`
+	Transpose   *bool       `json:"transpose,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	TextTemplate  string      `json:"texttemplate,omitempty"`
+	TextFont      *Font       `json:"textfont,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	XAxis       string `json:"xaxis,omitempty"`
+	YAxis       string `json:"yaxis,omitempty"`
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// NewHeatmap creates a new heatmap trace
+func NewHeatmap() *Heatmap {
+	return &Heatmap{
+		BaseTrace: BaseTrace{
+			Type: "heatmap",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (h *Heatmap) Validate() error {
+	if err := h.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	rows, columns, err := validateMatrix("Z", h.Z)
+	if err != nil {
+		return err
+	}
+
+	// Labels match the matrix dimensions, or hold the edges of the cells
+	if h.Transpose != nil && *h.Transpose {
+		rows, columns = columns, rows
+	}
+	if err := validateMatrixLabels("X", h.X, columns); err != nil {
+		return err
+	}
+	if err := validateMatrixLabels("Y", h.Y, rows); err != nil {
+		return err
+	}
+
+	if err := validateColorScale("ColorScale", h.ColorScale); err != nil {
+		return err
+	}
+	if err := validateColorRange("ZMin", h.ZMin, "ZMax", h.ZMax); err != nil {
+		return err
+	}
+
+	// Validate gaps
+	if h.XGap < 0 || h.YGap < 0 {
+		return &validation.ValidationError{
+			Field:   "XGap/YGap",
+			Message: "gaps must be non-negative",
+		}
+	}
+
+	return validateZSmooth(h.ZSmooth)
+}
+
+// validateZSmooth validates the smoothing of heatmap trace types, which is
+// "fast", "best" or false
+func validateZSmooth(zsmooth interface{}) error {
+	switch value := zsmooth.(type) {
+	case nil:
+	case bool:
+		if value {
+			return &validation.ValidationError{
+				Field:   "ZSmooth",
+				Message: "zsmooth must be \"fast\", \"best\" or false",
+			}
+		}
+	case string:
+		if value != ZSmoothFast && value != ZSmoothBest {
+			return &validation.ValidationError{
+				Field:   "ZSmooth",
+				Message: fmt.Sprintf("invalid zsmooth: %s", value),
+			}
+		}
+	default:
+		return &validation.ValidationError{
+			Field:   "ZSmooth",
+			Message: "zsmooth must be \"fast\", \"best\" or false",
+		}
+	}
+	return nil
+}
+
+// validateMatrix checks that a matrix is non-empty and rectangular, and
+// returns its number of rows and columns
+func validateMatrix(field string, z [][]float64) (int, int, error) {
+	if len(z) == 0 {
+		return 0, 0, &validation.ValidationError{
+			Field:   field,
+			Message: "z matrix must be provided",
+		}
+	}
+
+	columns := len(z[0])
+	for i, row := range z {
+		if len(row) != columns {
+			return 0, 0, &validation.ValidationError{
+				Field:   field,
+				Message: fmt.Sprintf("z matrix must be rectangular: row %d has %d values, expected %d", i, len(row), columns),
+			}
+		}
+	}
+	return len(z), columns, nil
+}
+
+// validateMatrixLabels checks that axis labels match a matrix dimension,
+// either one label per cell or one edge more
+func validateMatrixLabels(field string, labels interface{}, size int) error {
+	if labels == nil {
+		return nil
+	}
+	length, ok := arrayLength(labels)
+	if !ok {
+		return &validation.ValidationError{
+			Field:   field,
+			Message: "labels must be an array",
+		}
+	}
+	if length != size && length != size+1 {
+		return &validation.ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("%d labels do not match the %d cells of the z matrix", length, size),
+		}
+	}
+	return nil
+}
+
+// matrixJSON returns a matrix with NaN values replaced by nil, which plotly
+// draws as gaps
+func matrixJSON(z [][]float64) [][]interface{} {
+	out := make([][]interface{}, len(z))
+	for i, row := range z {
+		out[i] = make([]interface{}, len(row))
+		for j, v := range row {
+			if !math.IsNaN(v) && !math.IsInf(v, 0) {
+				out[i][j] = v
+			}
+		}
+	}
+	return out
+}
+
+// matrixFromJSON returns a decoded matrix with null values replaced by NaN
+func matrixFromJSON(z [][]*float64) [][]float64 {
+	if z == nil {
+		return nil
+	}
+	out := make([][]float64, len(z))
+	for i, row := range z {
+		out[i] = make([]float64, len(row))
+		for j, v := range row {
+			out[i][j] = math.NaN()
+			if v != nil {
+				out[i][j] = *v
+			}
+		}
+	}
+	return out
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, decoding gaps in
+// the z matrix as NaN
+func (h *Heatmap) UnmarshalJSON(data []byte) error {
+	type heatmap Heatmap
+	aux := struct {
+		*heatmap
+		Z [][]*float64 `json:"z"`
+	}{heatmap: (*heatmap)(h)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	h.Z = matrixFromJSON(aux.Z)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (h *Heatmap) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&h.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and data
+	m["type"] = "heatmap"
+	m["z"] = matrixJSON(h.Z)
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("x", h.X)
+	addIfNotEmpty("y", h.Y)
+
+	// Color Properties
+	addIfNotEmpty("colorscale", h.ColorScale)
+	if h.ReverseScale != nil {
+		m["reversescale"] = *h.ReverseScale
+	}
+	if h.ShowScale != nil {
+		m["showscale"] = *h.ShowScale
+	}
+	if h.ZMin != nil {
+		m["zmin"] = *h.ZMin
+	}
+	if h.ZMax != nil {
+		m["zmax"] = *h.ZMax
+	}
+	if h.ZMid != nil {
+		m["zmid"] = *h.ZMid
+	}
+	if h.ZAuto != nil {
+		m["zauto"] = *h.ZAuto
+	}
+	if h.ColorBar != nil {
+		m["colorbar"] = h.ColorBar
+	}
+
+	// Gap and Smoothing Properties
+	if h.XGap != 0 {
+		m["xgap"] = h.XGap
+	}
+	if h.YGap != 0 {
+		m["ygap"] = h.YGap
+	}
+	addIfNotEmpty("zsmooth", h.ZSmooth)
+	if h.ConnectGaps != nil {
+		m["connectgaps"] = *h.ConnectGaps
+	}
+	if h.HoverOnGaps != nil {
+		m["hoverongaps"] = *h.HoverOnGaps
+	}
+	if h.Transpose != nil {
+		m["transpose"] = *h.Transpose
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", h.Text)
+	if h.TextTemplate != "" {
+		m["texttemplate"] = h.TextTemplate
+	}
+	if h.TextFont != nil {
+		m["textfont"] = h.TextFont
+	}
+	addIfNotEmpty("hovertext", h.HoverText)
+	if h.HoverTemplate != "" {
+		m["hovertemplate"] = h.HoverTemplate
+	}
+	if h.HoverLabel != nil {
+		m["hoverlabel"] = h.HoverLabel
+	}
+
+	// Layout Properties
+	if h.XAxis != "" {
+		m["xaxis"] = h.XAxis
+	}
+	if h.YAxis != "" {
+		m["yaxis"] = h.YAxis
+	}
+	if h.LegendGroup != "" {
+		m["legendgroup"] = h.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
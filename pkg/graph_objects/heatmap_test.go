@@ -0,0 +1,196 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeatmapValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Heatmap)
+		expectedError string
+	}{
+		{
+			name:          "valid heatmap",
+			setup:         func(h *Heatmap) {},
+			expectedError: "",
+		},
+		{
+			name: "missing z",
+			setup: func(h *Heatmap) {
+				h.Z = nil
+			},
+			expectedError: "z matrix must be provided",
+		},
+		{
+			name: "ragged z",
+			setup: func(h *Heatmap) {
+				h.Z = [][]float64{{1, 2, 3}, {4, 5}}
+			},
+			expectedError: "row 1 has 2 values, expected 3",
+		},
+		{
+			name: "x labels do not match columns",
+			setup: func(h *Heatmap) {
+				h.X = []string{"a", "b"}
+			},
+			expectedError: "2 labels do not match the 3 cells",
+		},
+		{
+			name: "y labels do not match rows",
+			setup: func(h *Heatmap) {
+				h.Y = []int{1, 2, 3, 4}
+			},
+			expectedError: "4 labels do not match the 2 cells",
+		},
+		{
+			name: "cell edges",
+			setup: func(h *Heatmap) {
+				h.X = []float64{0, 1, 2, 3}
+				h.Y = []float64{0, 1, 2}
+			},
+			expectedError: "",
+		},
+		{
+			name: "transposed labels",
+			setup: func(h *Heatmap) {
+				h.Transpose = Bool(true)
+				h.X = []string{"a", "b"}
+				h.Y = []string{"a", "b", "c"}
+			},
+			expectedError: "",
+		},
+		{
+			name: "labels not an array",
+			setup: func(h *Heatmap) {
+				h.X = "a"
+			},
+			expectedError: "labels must be an array",
+		},
+		{
+			name: "unknown colorscale",
+			setup: func(h *Heatmap) {
+				h.ColorScale = "Sunset"
+			},
+			expectedError: "invalid colorscale name: Sunset",
+		},
+		{
+			name: "zmin above zmax",
+			setup: func(h *Heatmap) {
+				h.ZMin = Float64(10)
+				h.ZMax = Float64(5)
+			},
+			expectedError: "zmin must be less than zmax",
+		},
+		{
+			name: "negative gap",
+			setup: func(h *Heatmap) {
+				h.XGap = -1
+			},
+			expectedError: "gaps must be non-negative",
+		},
+		{
+			name: "invalid zsmooth",
+			setup: func(h *Heatmap) {
+				h.ZSmooth = "smooth"
+			},
+			expectedError: "invalid zsmooth: smooth",
+		},
+		{
+			name: "zsmooth disabled",
+			setup: func(h *Heatmap) {
+				h.ZSmooth = false
+			},
+			expectedError: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHeatmap()
+			h.Z = [][]float64{{1, 2, 3}, {4, 5, 6}}
+			h.X = []string{"00:00", "01:00", "02:00"}
+			h.Y = []string{"api", "web"}
+			tt.setup(h)
+
+			err := h.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestValidateColorScale(t *testing.T) {
+	tests := []struct {
+		name          string
+		colorScale    interface{}
+		expectedError string
+	}{
+		{name: "unset", colorScale: nil},
+		{name: "name", colorScale: ColorScaleViridis},
+		{name: "name in lower case", colorScale: "ylorrd"},
+		{name: "stops", colorScale: [][]interface{}{{0, "white"}, {0.5, "orange"}, {1, "red"}}},
+		{name: "decoded stops", colorScale: []interface{}{[]interface{}{0.0, "white"}, []interface{}{1.0, "red"}}},
+		{name: "unknown name", colorScale: "Sunset", expectedError: "invalid colorscale name"},
+		{name: "single stop", colorScale: [][]interface{}{{0, "white"}}, expectedError: "at least two"},
+		{name: "not starting at 0", colorScale: [][]interface{}{{0.2, "white"}, {1, "red"}}, expectedError: "must start at 0 and end at 1"},
+		{name: "decreasing positions", colorScale: [][]interface{}{{0, "white"}, {0.6, "orange"}, {0.4, "pink"}, {1, "red"}}, expectedError: "must increase from 0 to 1"},
+		{name: "color not a string", colorScale: [][]interface{}{{0, 1}, {1, "red"}}, expectedError: "color must be a string"},
+		{name: "wrong type", colorScale: 42, expectedError: "must be a name or a list"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateColorScale("ColorScale", tt.colorScale)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestHeatmapMarshalJSON(t *testing.T) {
+	h := NewHeatmap()
+	h.Z = [][]float64{{1, math.NaN()}, {3, 4}}
+	h.X = []string{"00:00", "01:00"}
+	h.Y = []string{"api", "web"}
+	h.ColorScale = ColorScaleYlOrRd
+	h.ZMin = Float64(0)
+	h.ColorBar = &ColorBar{Title: "ms", Thickness: 20}
+	h.TextTemplate = "%{z}"
+	h.XGap = 1
+
+	data, err := json.Marshal(h)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"heatmap"`)
+	assert.Contains(t, jsonStr, `"z":[[1,null],[3,4]]`)
+	assert.Contains(t, jsonStr, `"colorscale":"YlOrRd"`)
+	assert.Contains(t, jsonStr, `"zmin":0`)
+	assert.Contains(t, jsonStr, `"colorbar":{"title":"ms","thickness":20}`)
+	assert.Contains(t, jsonStr, `"texttemplate":"%{z}"`)
+	assert.Contains(t, jsonStr, `"xgap":1`)
+	assert.NotContains(t, jsonStr, `"zmax"`)
+
+	// Gaps decode as NaN
+	decoded, err := DecodeTrace(data)
+	assert.NoError(t, err)
+	heatmap, ok := decoded.(*Heatmap)
+	assert.True(t, ok)
+	assert.True(t, math.IsNaN(heatmap.Z[0][1]))
+	assert.Equal(t, 4.0, heatmap.Z[1][1])
+	assert.Equal(t, []interface{}{"api", "web"}, heatmap.Y)
+	assert.NoError(t, heatmap.Validate())
+}
@@ -0,0 +1,375 @@
+package graph_objects
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Branch values of hierarchical traces
+const (
+	// BranchValuesRemainder adds the values of the children to the value of
+	// their parent
+	BranchValuesRemainder = "remainder"
+	// BranchValuesTotal treats the value of a parent as the total of its
+	// children, which must not exceed it
+	BranchValuesTotal = "total"
+)
+
+// Hierarchical text info flags, combined with "+", e.g. "label+percent parent"
+const (
+	HierarchyTextInfoLabel         = "label"
+	HierarchyTextInfoText          = "text"
+	HierarchyTextInfoValue         = "value"
+	HierarchyTextInfoCurrentPath   = "current path"
+	HierarchyTextInfoPercentRoot   = "percent root"
+	HierarchyTextInfoPercentEntry  = "percent entry"
+	HierarchyTextInfoPercentParent = "percent parent"
+)
+
+// HierarchyPathSeparator joins the labels of a node and its ancestors into the
+// ids generated by HierarchyFromTree and HierarchyFromPaths
+const HierarchyPathSeparator = "/"
+
+// HierarchyNode represents a node of a tree for the hierarchical traces
+// Sunburst, Treemap and Icicle
+type HierarchyNode struct {
+	ID       string // optional, defaults to the path of labels from the root
+	Label    string
+	Value    float64
+	Children []*HierarchyNode
+}
+
+// HierarchyData holds the flat ids, labels, parents and values arrays that
+// hierarchical traces are built from
+type HierarchyData struct {
+	IDs     []string
+	Labels  []string
+	Parents []string
+	Values  []float64
+}
+
+// HierarchyMarker represents the sector colors of hierarchical traces
+type HierarchyMarker struct {
+	Colors       interface{} `json:"colors,omitempty"`     // one color per node
+	ColorScale   interface{} `json:"colorscale,omitempty"` // name or [position, color] pairs, applied to Colors
+	ReverseScale *bool       `json:"reversescale,omitempty"`
+	ShowScale    *bool       `json:"showscale,omitempty"`
+	ColorBar     *ColorBar   `json:"colorbar,omitempty"`
+	Line         *MarkerLine `json:"line,omitempty"`
+}
+
+// HierarchyLeaf represents the styling of the leaves of sunburst and icicle
+// traces
+type HierarchyLeaf struct {
+	Opacity *float64 `json:"opacity,omitempty"`
+}
+
+// PathBar represents the bar showing the path to the current root of treemap
+// and icicle traces
+type PathBar struct {
+	Visible   *bool   `json:"visible,omitempty"`
+	Side      string  `json:"side,omitempty"`      // "top" or "bottom"
+	EdgeShape string  `json:"edgeshape,omitempty"` // ">", "<", "|", "/" or "\\"
+	Thickness float64 `json:"thickness,omitempty"`
+	TextFont  *Font   `json:"textfont,omitempty"`
+}
+
+// HierarchyFromTree flattens trees into the arrays of hierarchical traces.
+// Nodes are listed depth first, and nodes without an ID get the path of
+// labels from their root joined by HierarchyPathSeparator. Nil nodes are
+// skipped.
+func HierarchyFromTree(roots ...*HierarchyNode) *HierarchyData {
+	data := &HierarchyData{}
+	var walk func(node *HierarchyNode, parentID, parentPath string)
+	walk = func(node *HierarchyNode, parentID, parentPath string) {
+		if node == nil {
+			return
+		}
+		path := node.Label
+		if parentPath != "" {
+			path = parentPath + HierarchyPathSeparator + node.Label
+		}
+		id := node.ID
+		if id == "" {
+			id = path
+		}
+
+		data.IDs = append(data.IDs, id)
+		data.Labels = append(data.Labels, node.Label)
+		data.Parents = append(data.Parents, parentID)
+		data.Values = append(data.Values, node.Value)
+		for _, child := range node.Children {
+			walk(child, id, path)
+		}
+	}
+	for _, root := range roots {
+		walk(root, "", "")
+	}
+	return data
+}
+
+// HierarchyFromPaths builds the arrays of hierarchical traces from label
+// paths, such as the directories of a file path, with one value per path.
+// Ancestors missing from the paths are added with a value of 0, so the values
+// add up with the default "remainder" branch values. Values of repeated paths
+// are summed. Labels must not contain HierarchyPathSeparator, which joins the
+// labels into ids.
+func HierarchyFromPaths(paths [][]string, values []float64) (*HierarchyData, error) {
+	if len(paths) != len(values) {
+		return nil, &validation.ValidationError{
+			Field:   "Values",
+			Message: fmt.Sprintf("%d values given for %d paths", len(values), len(paths)),
+		}
+	}
+
+	data := &HierarchyData{}
+	index := make(map[string]int)
+	for i, path := range paths {
+		if len(path) == 0 {
+			return nil, &validation.ValidationError{
+				Field:   "Paths",
+				Message: fmt.Sprintf("path %d is empty", i),
+			}
+		}
+
+		parentID := ""
+		for depth, label := range path {
+			if strings.Contains(label, HierarchyPathSeparator) {
+				return nil, &validation.ValidationError{
+					Field:   "Paths",
+					Message: fmt.Sprintf("label %q of path %d contains the path separator %q", label, i, HierarchyPathSeparator),
+				}
+			}
+			id := strings.Join(path[:depth+1], HierarchyPathSeparator)
+			if _, ok := index[id]; !ok {
+				index[id] = len(data.IDs)
+				data.IDs = append(data.IDs, id)
+				data.Labels = append(data.Labels, label)
+				data.Parents = append(data.Parents, parentID)
+				data.Values = append(data.Values, 0)
+			}
+			parentID = id
+		}
+		data.Values[index[parentID]] += values[i]
+	}
+	return data, nil
+}
+
+// validateHierarchy validates the ids, labels, parents and values of
+// hierarchical traces: every parent must exist, parents must not form cycles,
+// and with "total" branch values children must not exceed their parent
+func validateHierarchy(ids, labels, parents []string, values []float64, branchValues string) error {
+	if len(labels) == 0 {
+		return &validation.ValidationError{
+			Field:   "Labels",
+			Message: "labels must be provided",
+		}
+	}
+
+	arrays := []struct {
+		field  string
+		length int
+	}{
+		{"IDs", len(ids)},
+		{"Parents", len(parents)},
+		{"Values", len(values)},
+	}
+	for _, a := range arrays {
+		if a.length != 0 && a.length != len(labels) {
+			return &validation.ValidationError{
+				Field:   a.field,
+				Message: fmt.Sprintf("%d %s given for %d labels", a.length, strings.ToLower(a.field), len(labels)),
+			}
+		}
+	}
+
+	if branchValues != "" && branchValues != BranchValuesRemainder && branchValues != BranchValuesTotal {
+		return &validation.ValidationError{
+			Field:   "BranchValues",
+			Message: fmt.Sprintf("invalid branch values: %s", branchValues),
+		}
+	}
+
+	// Nodes are identified by their id, or by their label without ids
+	keys := ids
+	if len(keys) == 0 {
+		keys = labels
+	}
+	index := make(map[string]int, len(keys))
+	for i, key := range keys {
+		if _, ok := index[key]; ok {
+			return &validation.ValidationError{
+				Field:   "IDs",
+				Message: fmt.Sprintf("duplicate node: %s", key),
+			}
+		}
+		index[key] = i
+	}
+
+	for i, v := range values {
+		if v < 0 || math.IsNaN(v) {
+			return &validation.ValidationError{
+				Field:   "Values",
+				Message: fmt.Sprintf("value of %s must be non-negative", keys[i]),
+			}
+		}
+	}
+
+	if len(parents) == 0 {
+		return nil
+	}
+
+	// Every parent must be a node of the trace
+	for i, parent := range parents {
+		if parent == "" {
+			continue
+		}
+		if _, ok := index[parent]; !ok {
+			return &validation.ValidationError{
+				Field:   "Parents",
+				Message: fmt.Sprintf("parent %s of %s is not a node", parent, keys[i]),
+			}
+		}
+	}
+
+	// Following the parents from any node must end at a root
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make([]int, len(keys))
+	for start := range keys {
+		var chain []int
+		for i := start; state[i] != done; {
+			if state[i] == visiting {
+				return &validation.ValidationError{
+					Field:   "Parents",
+					Message: fmt.Sprintf("cycle in parents at %s", keys[i]),
+				}
+			}
+			state[i] = visiting
+			chain = append(chain, i)
+			if parents[i] == "" {
+				break
+			}
+			i = index[parents[i]]
+		}
+		for _, i := range chain {
+			state[i] = done
+		}
+	}
+
+	// With total branch values the children must fit in their parent
+	if branchValues == BranchValuesTotal && len(values) != 0 {
+		sums := make([]float64, len(keys))
+		for i, parent := range parents {
+			if parent != "" {
+				sums[index[parent]] += values[i]
+			}
+		}
+		for i, sum := range sums {
+			if sum > values[i]*(1+1e-9)+1e-9 {
+				return &validation.ValidationError{
+					Field:   "Values",
+					Message: fmt.Sprintf("children of %s sum to %g, which exceeds its value %g", keys[i], sum, values[i]),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateHierarchyTextInfo checks that text info is "none" or a "+"
+// separated list of flags
+func validateHierarchyTextInfo(textInfo string) error {
+	if textInfo == "" || textInfo == "none" {
+		return nil
+	}
+	validFlags := map[string]bool{
+		HierarchyTextInfoLabel:         true,
+		HierarchyTextInfoText:          true,
+		HierarchyTextInfoValue:         true,
+		HierarchyTextInfoCurrentPath:   true,
+		HierarchyTextInfoPercentRoot:   true,
+		HierarchyTextInfoPercentEntry:  true,
+		HierarchyTextInfoPercentParent: true,
+	}
+	return validateFlagList("TextInfo", "textinfo flag", textInfo, validFlags)
+}
+
+// validateHierarchyLayout validates the depth, marker and placement shared by
+// hierarchical traces
+func validateHierarchyLayout(maxDepth int, marker *HierarchyMarker, domain *Domain) error {
+	if maxDepth < -1 {
+		return &validation.ValidationError{
+			Field:   "MaxDepth",
+			Message: "max depth must be -1 for all levels or positive",
+		}
+	}
+	if marker != nil {
+		if err := validateColorScale("Marker.ColorScale", marker.ColorScale); err != nil {
+			return err
+		}
+	}
+	if domain != nil {
+		if err := domain.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *HierarchyLeaf) validate() error {
+	if l.Opacity != nil && (*l.Opacity < 0 || *l.Opacity > 1) {
+		return &validation.ValidationError{
+			Field:   "Leaf.Opacity",
+			Message: "leaf opacity must be between 0 and 1",
+		}
+	}
+	return nil
+}
+
+func (p *PathBar) validate() error {
+	if p.Side != "" && p.Side != "top" && p.Side != "bottom" {
+		return &validation.ValidationError{
+			Field:   "PathBar.Side",
+			Message: fmt.Sprintf("invalid path bar side: %s", p.Side),
+		}
+	}
+	validShapes := map[string]bool{">": true, "<": true, "|": true, "/": true, "\\": true}
+	if p.EdgeShape != "" && !validShapes[p.EdgeShape] {
+		return &validation.ValidationError{
+			Field:   "PathBar.EdgeShape",
+			Message: fmt.Sprintf("invalid path bar edge shape: %s", p.EdgeShape),
+		}
+	}
+	if p.Thickness < 0 {
+		return &validation.ValidationError{
+			Field:   "PathBar.Thickness",
+			Message: "path bar thickness must be non-negative",
+		}
+	}
+	return nil
+}
+
+// validateTiling validates the flip and padding shared by treemap and icicle
+// tilings
+func validateTiling(flip string, pad float64) error {
+	if flip != "" && flip != "x" && flip != "y" && flip != "x+y" {
+		return &validation.ValidationError{
+			Field:   "Tiling.Flip",
+			Message: fmt.Sprintf("invalid tiling flip: %s", flip),
+		}
+	}
+	if pad < 0 {
+		return &validation.ValidationError{
+			Field:   "Tiling.Pad",
+			Message: "tiling pad must be non-negative",
+		}
+	}
+	return nil
+}
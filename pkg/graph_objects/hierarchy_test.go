@@ -0,0 +1,153 @@
+package graph_objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHierarchyFromTree(t *testing.T) {
+	root := &HierarchyNode{
+		Label: "disk",
+		Value: 100,
+		Children: []*HierarchyNode{
+			{Label: "home", Value: 60, Children: []*HierarchyNode{
+				{Label: "alice", Value: 40},
+			}},
+			{ID: "var-dir", Label: "var", Value: 30},
+		},
+	}
+
+	data := HierarchyFromTree(root)
+	assert.Equal(t, []string{"disk", "disk/home", "disk/home/alice", "var-dir"}, data.IDs)
+	assert.Equal(t, []string{"disk", "home", "alice", "var"}, data.Labels)
+	assert.Equal(t, []string{"", "disk", "disk/home", "disk"}, data.Parents)
+	assert.Equal(t, []float64{100, 60, 40, 30}, data.Values)
+	assert.NoError(t, validateHierarchy(data.IDs, data.Labels, data.Parents, data.Values, BranchValuesTotal))
+
+	// Nil nodes are skipped
+	data = HierarchyFromTree(nil, &HierarchyNode{Label: "a", Children: []*HierarchyNode{nil, {Label: "b"}}})
+	assert.Equal(t, []string{"a", "a/b"}, data.IDs)
+	assert.Equal(t, []string{"", "a"}, data.Parents)
+}
+
+func TestHierarchyFromPaths(t *testing.T) {
+	data, err := HierarchyFromPaths(
+		[][]string{
+			{"compute", "api"},
+			{"compute", "batch"},
+			{"storage"},
+			{"compute", "api"},
+		},
+		[]float64{30, 20, 15, 5},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"compute", "compute/api", "compute/batch", "storage"}, data.IDs)
+	assert.Equal(t, []string{"compute", "api", "batch", "storage"}, data.Labels)
+	assert.Equal(t, []string{"", "compute", "compute", ""}, data.Parents)
+	assert.Equal(t, []float64{0, 35, 20, 15}, data.Values)
+	assert.NoError(t, validateHierarchy(data.IDs, data.Labels, data.Parents, data.Values, BranchValuesRemainder))
+
+	_, err = HierarchyFromPaths([][]string{{"a"}}, nil)
+	assert.ErrorContains(t, err, "0 values given for 1 paths")
+
+	_, err = HierarchyFromPaths([][]string{{"a"}, {}}, []float64{1, 2})
+	assert.ErrorContains(t, err, "path 1 is empty")
+
+	// Labels containing the separator would give colliding ids
+	_, err = HierarchyFromPaths([][]string{{"a", "b/c"}, {"a", "b", "c"}}, []float64{1, 2})
+	assert.ErrorContains(t, err, `label "b/c" of path 0 contains the path separator "/"`)
+}
+
+func TestValidateHierarchy(t *testing.T) {
+	tests := []struct {
+		name          string
+		ids           []string
+		labels        []string
+		parents       []string
+		values        []float64
+		branchValues  string
+		expectedError string
+	}{
+		{
+			name:    "labels as ids",
+			labels:  []string{"root", "a", "b"},
+			parents: []string{"", "root", "root"},
+			values:  []float64{10, 4, 5},
+		},
+		{
+			name:          "missing labels",
+			expectedError: "labels must be provided",
+		},
+		{
+			name:          "parents do not match labels",
+			labels:        []string{"root", "a"},
+			parents:       []string{""},
+			expectedError: "1 parents given for 2 labels",
+		},
+		{
+			name:          "invalid branch values",
+			labels:        []string{"root"},
+			branchValues:  "sum",
+			expectedError: "invalid branch values: sum",
+		},
+		{
+			name:          "duplicate ids",
+			ids:           []string{"a", "a"},
+			labels:        []string{"a", "b"},
+			expectedError: "duplicate node: a",
+		},
+		{
+			name:          "negative value",
+			labels:        []string{"root", "a"},
+			values:        []float64{1, -1},
+			expectedError: "value of a must be non-negative",
+		},
+		{
+			name:          "orphan parent",
+			labels:        []string{"root", "a"},
+			parents:       []string{"", "missing"},
+			expectedError: "parent missing of a is not a node",
+		},
+		{
+			name:          "cycle",
+			labels:        []string{"root", "a", "b", "c"},
+			parents:       []string{"", "c", "a", "b"},
+			expectedError: "cycle in parents",
+		},
+		{
+			name:          "self parent",
+			labels:        []string{"a"},
+			parents:       []string{"a"},
+			expectedError: "cycle in parents at a",
+		},
+		{
+			name:         "children exceed parent with remainder",
+			labels:       []string{"root", "a", "b"},
+			parents:      []string{"", "root", "root"},
+			values:       []float64{5, 4, 5},
+			branchValues: BranchValuesRemainder,
+		},
+		{
+			name:          "children exceed parent with total",
+			ids:           []string{"r", "r/a", "r/b"},
+			labels:        []string{"root", "a", "b"},
+			parents:       []string{"", "r", "r"},
+			values:        []float64{5, 4, 5},
+			branchValues:  BranchValuesTotal,
+			expectedError: "children of r sum to 9, which exceeds its value 5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateHierarchy(tt.ids, tt.labels, tt.parents, tt.values, tt.branchValues)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
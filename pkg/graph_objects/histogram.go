@@ -207,38 +207,12 @@ func (h *Histogram) Validate() error {
 		}
 	}
 
-	// Validate histogram function
-	if h.HistFunc != "" {
-		validFuncs := map[string]bool{
-			string(HistogramFunctionCount): true,
-			string(HistogramFunctionSum):   true,
-			string(HistogramFunctionAvg):   true,
-			string(HistogramFunctionMin):   true,
-			string(HistogramFunctionMax):   true,
-		}
-		if !validFuncs[h.HistFunc] {
-			return &validation.ValidationError{
-				Field:   "HistFunc",
-				Message: fmt.Sprintf("invalid histogram function: %s", h.HistFunc),
-			}
-		}
+	// Validate histogram function and normalization
+	if err := validateHistFunc(h.HistFunc); err != nil {
+		return err
 	}
-
-	// Validate normalization
-	if h.HistNorm != "" {
-		validNorms := map[string]bool{
-			string(NormalizationNone):        true,
-			string(NormalizationPercent):     true,
-			string(NormalizationProbability): true,
-			string(NormalizationDensity):     true,
-			string(NormalizationProbDensity): true,
-		}
-		if !validNorms[h.HistNorm] {
-			return &validation.ValidationError{
-				Field:   "HistNorm",
-				Message: fmt.Sprintf("invalid normalization: %s", h.HistNorm),
-			}
-		}
+	if err := validateHistNorm(h.HistNorm); err != nil {
+		return err
 	}
 
 	// Validate hover on
@@ -258,12 +232,12 @@ func (h *Histogram) Validate() error {
 
 	// Validate bins
 	if h.XBins != nil {
-		if err := h.validateBins(h.XBins, "XBins"); err != nil {
+		if err := validateBins(h.XBins, "XBins"); err != nil {
 			return err
 		}
 	}
 	if h.YBins != nil {
-		if err := h.validateBins(h.YBins, "YBins"); err != nil {
+		if err := validateBins(h.YBins, "YBins"); err != nil {
 			return err
 		}
 	}
@@ -318,7 +292,46 @@ func (h *Histogram) Validate() error {
 	return nil
 }
 
-func (h *Histogram) validateBins(bins *Bins, field string) error {
+// validateHistFunc validates the aggregation function of the histogram trace
+// types
+func validateHistFunc(histFunc string) error {
+	validFuncs := map[string]bool{
+		string(HistogramFunctionCount): true,
+		string(HistogramFunctionSum):   true,
+		string(HistogramFunctionAvg):   true,
+		string(HistogramFunctionMin):   true,
+		string(HistogramFunctionMax):   true,
+	}
+	if histFunc != "" && !validFuncs[histFunc] {
+		return &validation.ValidationError{
+			Field:   "HistFunc",
+			Message: fmt.Sprintf("invalid histogram function: %s", histFunc),
+		}
+	}
+	return nil
+}
+
+// validateHistNorm validates the normalization of the histogram trace types
+func validateHistNorm(histNorm string) error {
+	validNorms := map[string]bool{
+		string(NormalizationNone):        true,
+		string(NormalizationPercent):     true,
+		string(NormalizationProbability): true,
+		string(NormalizationDensity):     true,
+		string(NormalizationProbDensity): true,
+	}
+	if !validNorms[histNorm] {
+		return &validation.ValidationError{
+			Field:   "HistNorm",
+			Message: fmt.Sprintf("invalid normalization: %s", histNorm),
+		}
+	}
+	return nil
+}
+
+// validateBins validates explicit bins, which are shared by the histogram
+// trace types
+func validateBins(bins *Bins, field string) error {
 	if bins.Size <= 0 {
 		return &validation.ValidationError{
 			Field:   field + ".Size",
@@ -485,7 +498,7 @@ func (h *Histogram) validateErrorBars(bars *ErrorBars, field string) error {
 
 // MarshalJSON implements the json.Marshaler interface
 func (h *Histogram) MarshalJSON() ([]byte, error) {
-	return json.Marshal(struct {
+	data, err := json.Marshal(struct {
 		Type       string      `json:"type"`
 		X          interface{} `json:"x,omitempty"`
 		Y          interface{} `json:"y,omitempty"`
@@ -510,4 +523,19 @@ func (h *Histogram) MarshalJSON() ([]byte, error) {
 		YBins:      h.YBins,
 		ShowLegend: h.ShowLegend,
 	})
+	if err != nil || len(h.Extra) == 0 {
+		return data, err
+	}
+
+	// Add extra properties
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range h.Extra {
+		if _, exists := m[k]; !exists {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
 }
@@ -0,0 +1,238 @@
+package graph_objects
+
+import (
+	"encoding/json"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Histogram2d represents a 2D histogram trace, which bins paired x/y samples
+// into a heatmap
+type Histogram2d struct {
+	BaseTrace
+	// Data
+	X interface{} `json:"x"`           // x samples
+	Y interface{} `json:"y"`           // y samples
+	Z interface{} `json:"z,omitempty"` // sample values aggregated by HistFunc
+
+	// Binning Properties
+	NBinsX    int    `json:"nbinsx,omitempty"`
+	NBinsY    int    `json:"nbinsy,omitempty"`
+	XBins     *Bins  `json:"xbins,omitempty"`
+	YBins     *Bins  `json:"ybins,omitempty"`
+	AutoBinX  *bool  `json:"autobinx,omitempty"`
+	AutoBinY  *bool  `json:"autobiny,omitempty"`
+	XBinGroup string `json:"xbingroup,omitempty"`
+	YBinGroup string `json:"ybingroup,omitempty"`
+	HistFunc  string `json:"histfunc,omitempty"`
+	HistNorm  string `json:"histnorm,omitempty"`
+
+	// Color Properties
+	ColorScale   interface{} `json:"colorscale,omitempty"` // name or [position, color] pairs
+	ReverseScale *bool       `json:"reversescale,omitempty"`
+	ShowScale    *bool       `json:"showscale,omitempty"`
+	ZMin         *float64    `json:"zmin,omitempty"`
+	ZMax         *float64    `json:"zmax,omitempty"`
+	ZMid         *float64    `json:"zmid,omitempty"`
+	ZAuto        *bool       `json:"zauto,omitempty"`
+	ColorBar     *ColorBar   `json:"colorbar,omitempty"`
+
+	// Gap and Smoothing Properties
+	XGap    float64     `json:"xgap,omitempty"`
+	YGap    float64     `json:"ygap,omitempty"`
+	ZSmooth interface{} `json:"zsmooth,omitempty"` // "fast", "best" or false
+
+	// Text and Hover Properties
+	TextTemplate  string      `json:"texttemplate,omitempty"`
+	TextFont      *Font       `json:"textfont,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	XAxis       string `json:"xaxis,omitempty"`
+	YAxis       string `json:"yaxis,omitempty"`
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// NewHistogram2d creates a new 2D histogram trace
+func NewHistogram2d() *Histogram2d {
+	return &Histogram2d{
+		BaseTrace: BaseTrace{
+			Type: "histogram2d",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (h *Histogram2d) Validate() error {
+	if err := h.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateSamples2d(h.X, h.Y, h.Z); err != nil {
+		return err
+	}
+
+	// Validate histogram function and normalization
+	if err := validateHistFunc(h.HistFunc); err != nil {
+		return err
+	}
+	if err := validateHistNorm(h.HistNorm); err != nil {
+		return err
+	}
+
+	// Validate bins
+	if h.XBins != nil {
+		if err := validateBins(h.XBins, "XBins"); err != nil {
+			return err
+		}
+	}
+	if h.YBins != nil {
+		if err := validateBins(h.YBins, "YBins"); err != nil {
+			return err
+		}
+	}
+	if h.NBinsX < 0 || h.NBinsY < 0 {
+		return &validation.ValidationError{
+			Field:   "NBinsX/NBinsY",
+			Message: "number of bins must be non-negative",
+		}
+	}
+
+	if err := validateColorScale("ColorScale", h.ColorScale); err != nil {
+		return err
+	}
+	if err := validateColorRange("ZMin", h.ZMin, "ZMax", h.ZMax); err != nil {
+		return err
+	}
+
+	// Validate gaps
+	if h.XGap < 0 || h.YGap < 0 {
+		return &validation.ValidationError{
+			Field:   "XGap/YGap",
+			Message: "gaps must be non-negative",
+		}
+	}
+
+	return validateZSmooth(h.ZSmooth)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (h *Histogram2d) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&h.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and samples
+	m["type"] = "histogram2d"
+	m["x"] = h.X
+	m["y"] = h.Y
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("z", h.Z)
+
+	// Binning Properties
+	if h.NBinsX != 0 {
+		m["nbinsx"] = h.NBinsX
+	}
+	if h.NBinsY != 0 {
+		m["nbinsy"] = h.NBinsY
+	}
+	if h.XBins != nil {
+		m["xbins"] = h.XBins
+	}
+	if h.YBins != nil {
+		m["ybins"] = h.YBins
+	}
+	if h.AutoBinX != nil {
+		m["autobinx"] = *h.AutoBinX
+	}
+	if h.AutoBinY != nil {
+		m["autobiny"] = *h.AutoBinY
+	}
+	if h.XBinGroup != "" {
+		m["xbingroup"] = h.XBinGroup
+	}
+	if h.YBinGroup != "" {
+		m["ybingroup"] = h.YBinGroup
+	}
+	if h.HistFunc != "" {
+		m["histfunc"] = h.HistFunc
+	}
+	if h.HistNorm != "" {
+		m["histnorm"] = h.HistNorm
+	}
+
+	// Color Properties
+	addIfNotEmpty("colorscale", h.ColorScale)
+	if h.ReverseScale != nil {
+		m["reversescale"] = *h.ReverseScale
+	}
+	if h.ShowScale != nil {
+		m["showscale"] = *h.ShowScale
+	}
+	if h.ZMin != nil {
+		m["zmin"] = *h.ZMin
+	}
+	if h.ZMax != nil {
+		m["zmax"] = *h.ZMax
+	}
+	if h.ZMid != nil {
+		m["zmid"] = *h.ZMid
+	}
+	if h.ZAuto != nil {
+		m["zauto"] = *h.ZAuto
+	}
+	if h.ColorBar != nil {
+		m["colorbar"] = h.ColorBar
+	}
+
+	// Gap and Smoothing Properties
+	if h.XGap != 0 {
+		m["xgap"] = h.XGap
+	}
+	if h.YGap != 0 {
+		m["ygap"] = h.YGap
+	}
+	addIfNotEmpty("zsmooth", h.ZSmooth)
+
+	// Text and Hover Properties
+	if h.TextTemplate != "" {
+		m["texttemplate"] = h.TextTemplate
+	}
+	if h.TextFont != nil {
+		m["textfont"] = h.TextFont
+	}
+	if h.HoverTemplate != "" {
+		m["hovertemplate"] = h.HoverTemplate
+	}
+	if h.HoverLabel != nil {
+		m["hoverlabel"] = h.HoverLabel
+	}
+
+	// Layout Properties
+	if h.XAxis != "" {
+		m["xaxis"] = h.XAxis
+	}
+	if h.YAxis != "" {
+		m["yaxis"] = h.YAxis
+	}
+	if h.LegendGroup != "" {
+		m["legendgroup"] = h.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,152 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram2dValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Histogram2d)
+		expectedError string
+	}{
+		{
+			name:          "valid samples",
+			setup:         func(h *Histogram2d) {},
+			expectedError: "",
+		},
+		{
+			name: "missing x",
+			setup: func(h *Histogram2d) {
+				h.X = nil
+			},
+			expectedError: "both X and Y samples must be provided",
+		},
+		{
+			name: "mismatched sample counts",
+			setup: func(h *Histogram2d) {
+				h.X = []float64{1, 2, 3}
+			},
+			expectedError: "x and y must have the same number of samples (3 != 4)",
+		},
+		{
+			name: "z aggregated per bin",
+			setup: func(h *Histogram2d) {
+				h.Z = []float64{10, 20, 30, 40}
+				h.HistFunc = string(HistogramFunctionSum)
+			},
+			expectedError: "",
+		},
+		{
+			name: "z values do not match samples",
+			setup: func(h *Histogram2d) {
+				h.Z = []float64{10, 20, 30}
+			},
+			expectedError: "z must have one value per sample",
+		},
+		{
+			name: "invalid histfunc",
+			setup: func(h *Histogram2d) {
+				h.HistFunc = "median"
+			},
+			expectedError: "invalid histogram function: median",
+		},
+		{
+			name: "invalid histnorm",
+			setup: func(h *Histogram2d) {
+				h.HistNorm = "ratio"
+			},
+			expectedError: "invalid normalization: ratio",
+		},
+		{
+			name: "invalid bin range",
+			setup: func(h *Histogram2d) {
+				h.XBins = &Bins{Start: 5, End: 1, Size: 1}
+			},
+			expectedError: "XBins",
+		},
+		{
+			name: "negative nbins",
+			setup: func(h *Histogram2d) {
+				h.NBinsY = -1
+			},
+			expectedError: "number of bins must be non-negative",
+		},
+		{
+			name: "unknown colorscale",
+			setup: func(h *Histogram2d) {
+				h.ColorScale = "Sunset"
+			},
+			expectedError: "invalid colorscale name: Sunset",
+		},
+		{
+			name: "zmin above zmax",
+			setup: func(h *Histogram2d) {
+				h.ZMin = Float64(3)
+				h.ZMax = Float64(1)
+			},
+			expectedError: "zmin must be less than zmax",
+		},
+		{
+			name: "negative gap",
+			setup: func(h *Histogram2d) {
+				h.YGap = -2
+			},
+			expectedError: "gaps must be non-negative",
+		},
+		{
+			name: "invalid zsmooth",
+			setup: func(h *Histogram2d) {
+				h.ZSmooth = true
+			},
+			expectedError: "zsmooth must be",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHistogram2d()
+			h.X = []float64{1, 2, 2, 3}
+			h.Y = []float64{2, 4, 5, 7}
+			tt.setup(h)
+
+			err := h.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestHistogram2dMarshalJSON(t *testing.T) {
+	h := NewHistogram2d()
+	h.X = []float64{1, 2, 2, 3}
+	h.Y = []float64{2, 4, 5, 7}
+	h.Z = []float64{10, 20, 30, 40}
+	h.HistFunc = string(HistogramFunctionAvg)
+	h.NBinsX = 4
+	h.NBinsY = 8
+	h.ColorScale = ColorScaleViridis
+	h.ZSmooth = ZSmoothBest
+
+	data, err := json.Marshal(h)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"histogram2d"`)
+	assert.Contains(t, jsonStr, `"x":[1,2,2,3]`)
+	assert.Contains(t, jsonStr, `"z":[10,20,30,40]`)
+	assert.Contains(t, jsonStr, `"histfunc":"avg"`)
+	assert.Contains(t, jsonStr, `"nbinsx":4`)
+	assert.Contains(t, jsonStr, `"nbinsy":8`)
+	assert.Contains(t, jsonStr, `"colorscale":"Viridis"`)
+	assert.Contains(t, jsonStr, `"zsmooth":"best"`)
+	assert.NotContains(t, jsonStr, `"histnorm"`)
+	assert.NotContains(t, jsonStr, `"xbins"`)
+}
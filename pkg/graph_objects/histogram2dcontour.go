@@ -0,0 +1,280 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Histogram2dContour represents a 2D histogram contour trace, which bins x/y
+// samples and draws contours of the bin values
+type Histogram2dContour struct {
+	BaseTrace
+	// Data
+	X interface{} `json:"x"`           // x samples
+	Y interface{} `json:"y"`           // y samples
+	Z interface{} `json:"z,omitempty"` // sample values aggregated by HistFunc
+
+	// Binning Properties
+	NBinsX    int    `json:"nbinsx,omitempty"`
+	NBinsY    int    `json:"nbinsy,omitempty"`
+	XBins     *Bins  `json:"xbins,omitempty"`
+	YBins     *Bins  `json:"ybins,omitempty"`
+	AutoBinX  *bool  `json:"autobinx,omitempty"`
+	AutoBinY  *bool  `json:"autobiny,omitempty"`
+	XBinGroup string `json:"xbingroup,omitempty"`
+	YBinGroup string `json:"ybingroup,omitempty"`
+	HistFunc  string `json:"histfunc,omitempty"`
+	HistNorm  string `json:"histnorm,omitempty"`
+
+	// Contour Properties
+	Contours    *Contours    `json:"contours,omitempty"`
+	NContours   int          `json:"ncontours,omitempty"`
+	AutoContour *bool        `json:"autocontour,omitempty"`
+	Line        *ContourLine `json:"line,omitempty"`
+
+	// Color Properties
+	ColorScale   interface{} `json:"colorscale,omitempty"` // name or [position, color] pairs
+	ReverseScale *bool       `json:"reversescale,omitempty"`
+	ShowScale    *bool       `json:"showscale,omitempty"`
+	ZMin         *float64    `json:"zmin,omitempty"`
+	ZMax         *float64    `json:"zmax,omitempty"`
+	ZMid         *float64    `json:"zmid,omitempty"`
+	ZAuto        *bool       `json:"zauto,omitempty"`
+	ColorBar     *ColorBar   `json:"colorbar,omitempty"`
+
+	// Text and Hover Properties
+	TextTemplate  string      `json:"texttemplate,omitempty"`
+	TextFont      *Font       `json:"textfont,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	XAxis       string `json:"xaxis,omitempty"`
+	YAxis       string `json:"yaxis,omitempty"`
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// NewHistogram2dContour creates a new 2D histogram contour trace
+func NewHistogram2dContour() *Histogram2dContour {
+	return &Histogram2dContour{
+		BaseTrace: BaseTrace{
+			Type: "histogram2dcontour",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (h *Histogram2dContour) Validate() error {
+	if err := h.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateSamples2d(h.X, h.Y, h.Z); err != nil {
+		return err
+	}
+
+	// Validate histogram function and normalization
+	if err := validateHistFunc(h.HistFunc); err != nil {
+		return err
+	}
+	if err := validateHistNorm(h.HistNorm); err != nil {
+		return err
+	}
+
+	// Validate bins
+	if h.XBins != nil {
+		if err := validateBins(h.XBins, "XBins"); err != nil {
+			return err
+		}
+	}
+	if h.YBins != nil {
+		if err := validateBins(h.YBins, "YBins"); err != nil {
+			return err
+		}
+	}
+	if h.NBinsX < 0 || h.NBinsY < 0 {
+		return &validation.ValidationError{
+			Field:   "NBinsX/NBinsY",
+			Message: "number of bins must be non-negative",
+		}
+	}
+
+	if err := validateContourSettings(h.Contours, h.NContours, h.Line); err != nil {
+		return err
+	}
+
+	if err := validateColorScale("ColorScale", h.ColorScale); err != nil {
+		return err
+	}
+	return validateColorRange("ZMin", h.ZMin, "ZMax", h.ZMax)
+}
+
+// validateSamples2d checks that x and y samples are provided as arrays of the
+// same length, and that optional z values have one value per sample
+func validateSamples2d(x, y, z interface{}) error {
+	if x == nil || y == nil {
+		return &validation.ValidationError{
+			Field:   "X/Y",
+			Message: "both X and Y samples must be provided",
+		}
+	}
+
+	xLength, ok := arrayLength(x)
+	if !ok {
+		return &validation.ValidationError{
+			Field:   "X",
+			Message: "x samples must be an array",
+		}
+	}
+	yLength, ok := arrayLength(y)
+	if !ok {
+		return &validation.ValidationError{
+			Field:   "Y",
+			Message: "y samples must be an array",
+		}
+	}
+	if xLength != yLength {
+		return &validation.ValidationError{
+			Field:   "X/Y",
+			Message: fmt.Sprintf("x and y must have the same number of samples (%d != %d)", xLength, yLength),
+		}
+	}
+
+	if z != nil {
+		zLength, ok := arrayLength(z)
+		if !ok || zLength != xLength {
+			return &validation.ValidationError{
+				Field:   "Z",
+				Message: "z must have one value per sample",
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (h *Histogram2dContour) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&h.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and samples
+	m["type"] = "histogram2dcontour"
+	m["x"] = h.X
+	m["y"] = h.Y
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("z", h.Z)
+
+	// Binning Properties
+	if h.NBinsX != 0 {
+		m["nbinsx"] = h.NBinsX
+	}
+	if h.NBinsY != 0 {
+		m["nbinsy"] = h.NBinsY
+	}
+	if h.XBins != nil {
+		m["xbins"] = h.XBins
+	}
+	if h.YBins != nil {
+		m["ybins"] = h.YBins
+	}
+	if h.AutoBinX != nil {
+		m["autobinx"] = *h.AutoBinX
+	}
+	if h.AutoBinY != nil {
+		m["autobiny"] = *h.AutoBinY
+	}
+	if h.XBinGroup != "" {
+		m["xbingroup"] = h.XBinGroup
+	}
+	if h.YBinGroup != "" {
+		m["ybingroup"] = h.YBinGroup
+	}
+	if h.HistFunc != "" {
+		m["histfunc"] = h.HistFunc
+	}
+	if h.HistNorm != "" {
+		m["histnorm"] = h.HistNorm
+	}
+
+	// Contour Properties
+	if h.Contours != nil {
+		m["contours"] = h.Contours
+	}
+	if h.NContours != 0 {
+		m["ncontours"] = h.NContours
+	}
+	if h.AutoContour != nil {
+		m["autocontour"] = *h.AutoContour
+	}
+	if h.Line != nil {
+		m["line"] = h.Line
+	}
+
+	// Color Properties
+	addIfNotEmpty("colorscale", h.ColorScale)
+	if h.ReverseScale != nil {
+		m["reversescale"] = *h.ReverseScale
+	}
+	if h.ShowScale != nil {
+		m["showscale"] = *h.ShowScale
+	}
+	if h.ZMin != nil {
+		m["zmin"] = *h.ZMin
+	}
+	if h.ZMax != nil {
+		m["zmax"] = *h.ZMax
+	}
+	if h.ZMid != nil {
+		m["zmid"] = *h.ZMid
+	}
+	if h.ZAuto != nil {
+		m["zauto"] = *h.ZAuto
+	}
+	if h.ColorBar != nil {
+		m["colorbar"] = h.ColorBar
+	}
+
+	// Text and Hover Properties
+	if h.TextTemplate != "" {
+		m["texttemplate"] = h.TextTemplate
+	}
+	if h.TextFont != nil {
+		m["textfont"] = h.TextFont
+	}
+	if h.HoverTemplate != "" {
+		m["hovertemplate"] = h.HoverTemplate
+	}
+	if h.HoverLabel != nil {
+		m["hoverlabel"] = h.HoverLabel
+	}
+
+	// Layout Properties
+	if h.XAxis != "" {
+		m["xaxis"] = h.XAxis
+	}
+	if h.YAxis != "" {
+		m["yaxis"] = h.YAxis
+	}
+	if h.LegendGroup != "" {
+		m["legendgroup"] = h.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
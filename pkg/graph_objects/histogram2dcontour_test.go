@@ -0,0 +1,140 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogram2dContourValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Histogram2dContour)
+		expectedError string
+	}{
+		{
+			name:          "valid samples",
+			setup:         func(h *Histogram2dContour) {},
+			expectedError: "",
+		},
+		{
+			name: "missing y",
+			setup: func(h *Histogram2dContour) {
+				h.Y = nil
+			},
+			expectedError: "both X and Y samples must be provided",
+		},
+		{
+			name: "x not an array",
+			setup: func(h *Histogram2dContour) {
+				h.X = 1.5
+			},
+			expectedError: "x samples must be an array",
+		},
+		{
+			name: "mismatched sample counts",
+			setup: func(h *Histogram2dContour) {
+				h.Y = []float64{1, 2}
+			},
+			expectedError: "x and y must have the same number of samples (4 != 2)",
+		},
+		{
+			name: "z values per sample",
+			setup: func(h *Histogram2dContour) {
+				h.Z = []float64{1, 2, 3, 4}
+				h.HistFunc = string(HistogramFunctionAvg)
+			},
+			expectedError: "",
+		},
+		{
+			name: "z values do not match samples",
+			setup: func(h *Histogram2dContour) {
+				h.Z = []float64{1, 2}
+			},
+			expectedError: "z must have one value per sample",
+		},
+		{
+			name: "invalid histfunc",
+			setup: func(h *Histogram2dContour) {
+				h.HistFunc = "median"
+			},
+			expectedError: "invalid histogram function: median",
+		},
+		{
+			name: "invalid histnorm",
+			setup: func(h *Histogram2dContour) {
+				h.HistNorm = "ratio"
+			},
+			expectedError: "invalid normalization: ratio",
+		},
+		{
+			name: "explicit bins",
+			setup: func(h *Histogram2dContour) {
+				h.XBins = &Bins{Start: 0, End: 4, Size: 0.5}
+				h.YBins = &Bins{Start: 0, End: 8, Size: 1}
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid bin size",
+			setup: func(h *Histogram2dContour) {
+				h.YBins = &Bins{Start: 0, End: 8, Size: 0}
+			},
+			expectedError: "YBins.Size",
+		},
+		{
+			name: "negative nbins",
+			setup: func(h *Histogram2dContour) {
+				h.NBinsX = -5
+			},
+			expectedError: "number of bins must be non-negative",
+		},
+		{
+			name: "invalid contour coloring",
+			setup: func(h *Histogram2dContour) {
+				h.Contours = &Contours{Coloring: "gradient"}
+			},
+			expectedError: "invalid contour coloring",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHistogram2dContour()
+			h.X = []float64{1, 2, 2, 3}
+			h.Y = []float64{2, 4, 5, 7}
+			tt.setup(h)
+
+			err := h.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestHistogram2dContourMarshalJSON(t *testing.T) {
+	h := NewHistogram2dContour()
+	h.X = []float64{1, 2, 2, 3}
+	h.Y = []float64{2, 4, 5, 7}
+	h.XBins = &Bins{Start: 0, End: 4, Size: 1}
+	h.NBinsY = 10
+	h.HistNorm = string(NormalizationProbability)
+	h.Contours = &Contours{Coloring: ContourColoringLines}
+
+	data, err := json.Marshal(h)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"histogram2dcontour"`)
+	assert.Contains(t, jsonStr, `"x":[1,2,2,3]`)
+	assert.Contains(t, jsonStr, `"xbins":{"end":4,"size":1}`)
+	assert.Contains(t, jsonStr, `"nbinsy":10`)
+	assert.Contains(t, jsonStr, `"histnorm":"probability"`)
+	assert.Contains(t, jsonStr, `"contours":{"coloring":"lines"}`)
+	assert.NotContains(t, jsonStr, `"z"`)
+}
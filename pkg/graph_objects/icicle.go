@@ -0,0 +1,191 @@
+package graph_objects
+
+import "encoding/json"
+
+// Icicle represents an icicle trace, which draws a hierarchy as stacked
+// rows or columns of rectangles
+type Icicle struct {
+	BaseTrace
+	// Data
+	IDs          []string  `json:"ids,omitempty"`
+	Labels       []string  `json:"labels"`
+	Parents      []string  `json:"parents,omitempty"` // id of the parent of each node, "" for roots
+	Values       []float64 `json:"values,omitempty"`
+	BranchValues string    `json:"branchvalues,omitempty"` // "remainder" or "total"
+
+	// Layout Properties
+	Level    string           `json:"level,omitempty"`    // id of the node shown as the root
+	MaxDepth int              `json:"maxdepth,omitempty"` // number of levels shown, -1 for all
+	Sort     *bool            `json:"sort,omitempty"`
+	Domain   *Domain          `json:"domain,omitempty"`
+	Marker   *HierarchyMarker `json:"marker,omitempty"`
+	Tiling   *IcicleTiling    `json:"tiling,omitempty"`
+	PathBar  *PathBar         `json:"pathbar,omitempty"`
+	Leaf     *HierarchyLeaf   `json:"leaf,omitempty"`
+
+	// Text and Hover Properties
+	Text            interface{} `json:"text,omitempty"`
+	TextInfo        string      `json:"textinfo,omitempty"` // flags such as "label+percent parent", or "none"
+	TextTemplate    string      `json:"texttemplate,omitempty"`
+	TextFont        *Font       `json:"textfont,omitempty"`
+	InsideTextFont  *Font       `json:"insidetextfont,omitempty"`
+	OutsideTextFont *Font       `json:"outsidetextfont,omitempty"`
+	HoverText       interface{} `json:"hovertext,omitempty"`
+	HoverTemplate   string      `json:"hovertemplate,omitempty"`
+	HoverLabel      *HoverLabel `json:"hoverlabel,omitempty"`
+}
+
+// IcicleTiling represents the direction and spacing of the levels of an
+// icicle trace
+type IcicleTiling struct {
+	Orientation string  `json:"orientation,omitempty"` // "h" for levels from left to right, "v" from top to bottom
+	Flip        string  `json:"flip,omitempty"`        // "x", "y" or "x+y"
+	Pad         float64 `json:"pad,omitempty"`         // padding between nodes in pixels
+}
+
+func (t *IcicleTiling) validate() error {
+	if err := validateBarOrientation(t.Orientation); err != nil {
+		return err
+	}
+	return validateTiling(t.Flip, t.Pad)
+}
+
+// NewIcicle creates a new icicle trace
+func NewIcicle() *Icicle {
+	return &Icicle{
+		BaseTrace: BaseTrace{
+			Type: "icicle",
+		},
+	}
+}
+
+// SetHierarchy sets the ids, labels, parents and values of the trace, as
+// built by HierarchyFromTree or HierarchyFromPaths
+func (i *Icicle) SetHierarchy(data *HierarchyData) {
+	i.IDs = data.IDs
+	i.Labels = data.Labels
+	i.Parents = data.Parents
+	i.Values = data.Values
+}
+
+// Validate implements the Validator interface
+func (i *Icicle) Validate() error {
+	if err := i.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateHierarchy(i.IDs, i.Labels, i.Parents, i.Values, i.BranchValues); err != nil {
+		return err
+	}
+	if err := validateHierarchyLayout(i.MaxDepth, i.Marker, i.Domain); err != nil {
+		return err
+	}
+	if i.Tiling != nil {
+		if err := i.Tiling.validate(); err != nil {
+			return err
+		}
+	}
+	if i.PathBar != nil {
+		if err := i.PathBar.validate(); err != nil {
+			return err
+		}
+	}
+	if i.Leaf != nil {
+		if err := i.Leaf.validate(); err != nil {
+			return err
+		}
+	}
+
+	return validateHierarchyTextInfo(i.TextInfo)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (i *Icicle) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&i.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and labels
+	m["type"] = "icicle"
+	m["labels"] = i.Labels
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	if i.IDs != nil {
+		m["ids"] = i.IDs
+	}
+	if i.Parents != nil {
+		m["parents"] = i.Parents
+	}
+	if i.Values != nil {
+		m["values"] = i.Values
+	}
+	if i.BranchValues != "" {
+		m["branchvalues"] = i.BranchValues
+	}
+
+	// Layout Properties
+	if i.Level != "" {
+		m["level"] = i.Level
+	}
+	if i.MaxDepth != 0 {
+		m["maxdepth"] = i.MaxDepth
+	}
+	if i.Sort != nil {
+		m["sort"] = *i.Sort
+	}
+	if i.Domain != nil {
+		m["domain"] = i.Domain
+	}
+	if i.Marker != nil {
+		m["marker"] = i.Marker
+	}
+	if i.Tiling != nil {
+		m["tiling"] = i.Tiling
+	}
+	if i.PathBar != nil {
+		m["pathbar"] = i.PathBar
+	}
+	if i.Leaf != nil {
+		m["leaf"] = i.Leaf
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", i.Text)
+	if i.TextInfo != "" {
+		m["textinfo"] = i.TextInfo
+	}
+	if i.TextTemplate != "" {
+		m["texttemplate"] = i.TextTemplate
+	}
+	if i.TextFont != nil {
+		m["textfont"] = i.TextFont
+	}
+	if i.InsideTextFont != nil {
+		m["insidetextfont"] = i.InsideTextFont
+	}
+	if i.OutsideTextFont != nil {
+		m["outsidetextfont"] = i.OutsideTextFont
+	}
+	addIfNotEmpty("hovertext", i.HoverText)
+	if i.HoverTemplate != "" {
+		m["hovertemplate"] = i.HoverTemplate
+	}
+	if i.HoverLabel != nil {
+		m["hoverlabel"] = i.HoverLabel
+	}
+
+	return json.Marshal(m)
+}
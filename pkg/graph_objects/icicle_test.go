@@ -0,0 +1,84 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIcicleValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Icicle)
+		expectedError string
+	}{
+		{
+			name:          "valid icicle",
+			setup:         func(i *Icicle) {},
+			expectedError: "",
+		},
+		{
+			name: "values do not match labels",
+			setup: func(i *Icicle) {
+				i.Values = []float64{1, 2}
+			},
+			expectedError: "2 values given for 3 labels",
+		},
+		{
+			name: "invalid tiling orientation",
+			setup: func(i *Icicle) {
+				i.Tiling = &IcicleTiling{Orientation: "x"}
+			},
+			expectedError: "invalid orientation: x",
+		},
+		{
+			name: "negative tiling pad",
+			setup: func(i *Icicle) {
+				i.Tiling = &IcicleTiling{Orientation: "v", Pad: -1}
+			},
+			expectedError: "tiling pad must be non-negative",
+		},
+		{
+			name: "invalid domain",
+			setup: func(i *Icicle) {
+				i.Domain = &Domain{Y: []float64{0.6, 0.2}}
+			},
+			expectedError: "domain must be a [start, end] range within [0, 1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := NewIcicle()
+			i.Labels = []string{"total", "compute", "storage"}
+			i.Parents = []string{"", "total", "total"}
+			i.Values = []float64{0, 60, 40}
+			tt.setup(i)
+
+			err := i.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestIcicleMarshalJSON(t *testing.T) {
+	i := NewIcicle()
+	i.Labels = []string{"total", "compute"}
+	i.Parents = []string{"", "total"}
+	i.Tiling = &IcicleTiling{Orientation: "v", Flip: "y"}
+	i.Leaf = &HierarchyLeaf{Opacity: Float64(0.8)}
+
+	data, err := json.Marshal(i)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"icicle"`)
+	assert.Contains(t, jsonStr, `"tiling":{"orientation":"v","flip":"y"}`)
+	assert.Contains(t, jsonStr, `"leaf":{"opacity":0.8}`)
+}
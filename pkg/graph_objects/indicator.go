@@ -0,0 +1,414 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Indicator modes, combined with "+" such as "number+delta"
+const (
+	IndicatorModeNumber = "number"
+	IndicatorModeDelta  = "delta"
+	IndicatorModeGauge  = "gauge"
+)
+
+// Gauge shapes
+const (
+	GaugeShapeAngular = "angular"
+	GaugeShapeBullet  = "bullet"
+)
+
+// Delta positions, relative to the number
+const (
+	DeltaPositionTop    = "top"
+	DeltaPositionBottom = "bottom"
+	DeltaPositionLeft   = "left"
+	DeltaPositionRight  = "right"
+)
+
+// Indicator represents an indicator trace, drawn as a single value with an
+// optional delta to a reference and a gauge
+type Indicator struct {
+	BaseTrace
+	// Data
+	Mode  string  `json:"mode,omitempty"` // "number", "delta" and "gauge" joined with "+"
+	Value float64 `json:"value"`
+
+	// Display Properties
+	Number *IndicatorNumber `json:"number,omitempty"`
+	Delta  *IndicatorDelta  `json:"delta,omitempty"`
+	Gauge  *Gauge           `json:"gauge,omitempty"`
+	Title  *IndicatorTitle  `json:"title,omitempty"`
+	Align  string           `json:"align,omitempty"` // "left", "center" or "right"
+
+	// Layout Properties
+	Domain *Domain `json:"domain,omitempty"`
+}
+
+// IndicatorTitle represents the title of an indicator
+type IndicatorTitle struct {
+	Text  string `json:"text,omitempty"`
+	Align string `json:"align,omitempty"` // "left", "center" or "right"
+	Font  *Font  `json:"font,omitempty"`
+}
+
+// IndicatorNumber represents the formatting of the value of an indicator
+type IndicatorNumber struct {
+	ValueFormat string `json:"valueformat,omitempty"` // d3 number format, e.g. ",.0f"
+	Prefix      string `json:"prefix,omitempty"`
+	Suffix      string `json:"suffix,omitempty"`
+	Font        *Font  `json:"font,omitempty"`
+}
+
+// IndicatorDelta represents the difference between the value of an indicator
+// and a reference value. A relative delta is shown as a fraction of the
+// reference.
+type IndicatorDelta struct {
+	Reference   *float64        `json:"reference,omitempty"`
+	Relative    *bool           `json:"relative,omitempty"`
+	Position    string          `json:"position,omitempty"`    // "top", "bottom", "left" or "right"
+	ValueFormat string          `json:"valueformat,omitempty"` // d3 number format, e.g. ".1%" for relative deltas
+	Prefix      string          `json:"prefix,omitempty"`
+	Suffix      string          `json:"suffix,omitempty"`
+	Increasing  *DeltaDirection `json:"increasing,omitempty"`
+	Decreasing  *DeltaDirection `json:"decreasing,omitempty"`
+	Font        *Font           `json:"font,omitempty"`
+}
+
+// DeltaDirection represents the symbol and color of an increasing or
+// decreasing delta
+type DeltaDirection struct {
+	Symbol string `json:"symbol,omitempty"`
+	Color  string `json:"color,omitempty"`
+}
+
+// Gauge represents the gauge of an indicator, an angular dial or a
+// horizontal bullet bar
+type Gauge struct {
+	Shape       string          `json:"shape,omitempty"` // "angular" or "bullet"
+	Axis        *GaugeAxis      `json:"axis,omitempty"`
+	Bar         *GaugeBar       `json:"bar,omitempty"`
+	Steps       []GaugeStep     `json:"steps,omitempty"`
+	Threshold   *GaugeThreshold `json:"threshold,omitempty"`
+	BgColor     string          `json:"bgcolor,omitempty"`
+	BorderColor string          `json:"bordercolor,omitempty"`
+	BorderWidth float64         `json:"borderwidth,omitempty"`
+}
+
+// GaugeAxis represents the axis of a gauge
+type GaugeAxis struct {
+	Range      []float64   `json:"range,omitempty"` // [min, max]
+	Visible    *bool       `json:"visible,omitempty"`
+	TickVals   interface{} `json:"tickvals,omitempty"`
+	TickText   interface{} `json:"ticktext,omitempty"`
+	DTick      interface{} `json:"dtick,omitempty"`
+	NTicks     int         `json:"nticks,omitempty"`
+	TickFormat string      `json:"tickformat,omitempty"`
+	TickPrefix string      `json:"tickprefix,omitempty"`
+	TickSuffix string      `json:"ticksuffix,omitempty"`
+	TickColor  string      `json:"tickcolor,omitempty"`
+	TickWidth  float64     `json:"tickwidth,omitempty"`
+	TickFont   *Font       `json:"tickfont,omitempty"`
+}
+
+// GaugeBar represents the bar of a gauge that shows the value
+type GaugeBar struct {
+	Color     string     `json:"color,omitempty"`
+	Line      *GaugeLine `json:"line,omitempty"`
+	Thickness *float64   `json:"thickness,omitempty"` // fraction of the gauge thickness, 0-1
+}
+
+// GaugeStep represents a colored range of a gauge, such as a warning zone
+type GaugeStep struct {
+	Range     []float64  `json:"range"` // [start, end] within the gauge axis range
+	Color     string     `json:"color,omitempty"`
+	Line      *GaugeLine `json:"line,omitempty"`
+	Thickness *float64   `json:"thickness,omitempty"` // fraction of the gauge thickness, 0-1
+	Name      string     `json:"name,omitempty"`
+}
+
+// GaugeThreshold represents a line marking a value on a gauge
+type GaugeThreshold struct {
+	Value     float64    `json:"value"`
+	Line      *GaugeLine `json:"line,omitempty"`
+	Thickness *float64   `json:"thickness,omitempty"` // fraction of the gauge thickness, 0-1
+}
+
+// GaugeLine represents the lines of gauge bars, steps and thresholds
+type GaugeLine struct {
+	Color string  `json:"color,omitempty"`
+	Width float64 `json:"width,omitempty"`
+}
+
+// NewIndicator creates a new indicator trace
+func NewIndicator() *Indicator {
+	return &Indicator{
+		BaseTrace: BaseTrace{
+			Type: "indicator",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (i *Indicator) Validate() error {
+	if err := i.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	// Validate the mode and that settings are only given for shown parts
+	mode := i.Mode
+	if mode == "" {
+		mode = IndicatorModeNumber
+	}
+	validModes := map[string]bool{
+		IndicatorModeNumber: true,
+		IndicatorModeDelta:  true,
+		IndicatorModeGauge:  true,
+	}
+	if err := validateFlagList("Mode", "mode flag", mode, validModes); err != nil {
+		return err
+	}
+	modes := make(map[string]bool)
+	for _, flag := range strings.Split(mode, "+") {
+		if modes[flag] {
+			return &validation.ValidationError{
+				Field:   "Mode",
+				Message: fmt.Sprintf("mode flag %s is repeated", flag),
+			}
+		}
+		modes[flag] = true
+	}
+
+	settings := []struct {
+		field string
+		mode  string
+		set   bool
+	}{
+		{"Number", IndicatorModeNumber, i.Number != nil},
+		{"Delta", IndicatorModeDelta, i.Delta != nil},
+		{"Gauge", IndicatorModeGauge, i.Gauge != nil},
+	}
+	for _, s := range settings {
+		if s.set && !modes[s.mode] {
+			return &validation.ValidationError{
+				Field:   s.field,
+				Message: fmt.Sprintf("%s settings require the %s mode, got mode %s", s.mode, s.mode, mode),
+			}
+		}
+	}
+
+	if modes[IndicatorModeDelta] {
+		if i.Delta == nil || i.Delta.Reference == nil {
+			return &validation.ValidationError{
+				Field:   "Delta.Reference",
+				Message: "a reference must be provided with the delta mode",
+			}
+		}
+		if err := i.Delta.validate(); err != nil {
+			return err
+		}
+	}
+
+	if i.Gauge != nil {
+		if err := i.Gauge.validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateIndicatorAlign("Align", i.Align); err != nil {
+		return err
+	}
+	if i.Title != nil {
+		if err := validateIndicatorAlign("Title.Align", i.Title.Align); err != nil {
+			return err
+		}
+	}
+
+	if i.Domain != nil {
+		return i.Domain.validate()
+	}
+	return nil
+}
+
+// validateIndicatorAlign validates the alignment of an indicator or its title
+func validateIndicatorAlign(field, align string) error {
+	if align != "" && align != "left" && align != "center" && align != "right" {
+		return &validation.ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("invalid alignment: %s", align),
+		}
+	}
+	return nil
+}
+
+func (d *IndicatorDelta) validate() error {
+	if d.Relative != nil && *d.Relative && *d.Reference == 0 {
+		return &validation.ValidationError{
+			Field:   "Delta.Reference",
+			Message: "a relative delta requires a non-zero reference",
+		}
+	}
+
+	if d.Position != "" {
+		validPositions := map[string]bool{
+			DeltaPositionTop:    true,
+			DeltaPositionBottom: true,
+			DeltaPositionLeft:   true,
+			DeltaPositionRight:  true,
+		}
+		if !validPositions[d.Position] {
+			return &validation.ValidationError{
+				Field:   "Delta.Position",
+				Message: fmt.Sprintf("invalid delta position: %s", d.Position),
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Gauge) validate() error {
+	if g.Shape != "" && g.Shape != GaugeShapeAngular && g.Shape != GaugeShapeBullet {
+		return &validation.ValidationError{
+			Field:   "Gauge.Shape",
+			Message: fmt.Sprintf("invalid gauge shape: %s", g.Shape),
+		}
+	}
+
+	// Steps and the threshold must lie within the axis range when it is set
+	var axisRange []float64
+	if g.Axis != nil && g.Axis.Range != nil {
+		if len(g.Axis.Range) != 2 || g.Axis.Range[0] >= g.Axis.Range[1] {
+			return &validation.ValidationError{
+				Field:   "Gauge.Axis.Range",
+				Message: "range must be a [min, max] range of values",
+			}
+		}
+		axisRange = g.Axis.Range
+	}
+	if g.Axis != nil {
+		if g.Axis.TickWidth < 0 {
+			return &validation.ValidationError{
+				Field:   "Gauge.Axis.TickWidth",
+				Message: "tick width must be non-negative",
+			}
+		}
+		if g.Axis.NTicks < 0 {
+			return &validation.ValidationError{
+				Field:   "Gauge.Axis.NTicks",
+				Message: "number of ticks must be non-negative",
+			}
+		}
+	}
+
+	for i, step := range g.Steps {
+		field := fmt.Sprintf("Gauge.Steps[%d]", i)
+		if len(step.Range) != 2 || step.Range[0] > step.Range[1] {
+			return &validation.ValidationError{
+				Field:   field + ".Range",
+				Message: "step range must be a [start, end] range of values",
+			}
+		}
+		if axisRange != nil && (step.Range[0] < axisRange[0] || step.Range[1] > axisRange[1]) {
+			return &validation.ValidationError{
+				Field:   field + ".Range",
+				Message: fmt.Sprintf("step range [%g, %g] is outside the gauge range [%g, %g]", step.Range[0], step.Range[1], axisRange[0], axisRange[1]),
+			}
+		}
+		if err := validateGaugePart(field, step.Line, step.Thickness); err != nil {
+			return err
+		}
+	}
+
+	if t := g.Threshold; t != nil {
+		if axisRange != nil && (t.Value < axisRange[0] || t.Value > axisRange[1]) {
+			return &validation.ValidationError{
+				Field:   "Gauge.Threshold.Value",
+				Message: fmt.Sprintf("threshold %g is outside the gauge range [%g, %g]", t.Value, axisRange[0], axisRange[1]),
+			}
+		}
+		if err := validateGaugePart("Gauge.Threshold", t.Line, t.Thickness); err != nil {
+			return err
+		}
+	}
+
+	if g.Bar != nil {
+		if err := validateGaugePart("Gauge.Bar", g.Bar.Line, g.Bar.Thickness); err != nil {
+			return err
+		}
+	}
+
+	if g.BorderWidth < 0 {
+		return &validation.ValidationError{
+			Field:   "Gauge.BorderWidth",
+			Message: "border width must be non-negative",
+		}
+	}
+	return nil
+}
+
+// validateGaugePart checks the line width and thickness of a gauge bar, step
+// or threshold
+func validateGaugePart(field string, line *GaugeLine, thickness *float64) error {
+	if line != nil && line.Width < 0 {
+		return &validation.ValidationError{
+			Field:   field + ".Line.Width",
+			Message: "line width must be non-negative",
+		}
+	}
+	if thickness != nil && (*thickness < 0 || *thickness > 1) {
+		return &validation.ValidationError{
+			Field:   field + ".Thickness",
+			Message: "thickness must be between 0 and 1",
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (i *Indicator) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&i.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and value
+	m["type"] = "indicator"
+	m["value"] = i.Value
+
+	if i.Mode != "" {
+		m["mode"] = i.Mode
+	}
+
+	// Display Properties
+	if i.Number != nil {
+		m["number"] = i.Number
+	}
+	if i.Delta != nil {
+		m["delta"] = i.Delta
+	}
+	if i.Gauge != nil {
+		m["gauge"] = i.Gauge
+	}
+	if i.Title != nil {
+		m["title"] = i.Title
+	}
+	if i.Align != "" {
+		m["align"] = i.Align
+	}
+
+	// Layout Properties
+	if i.Domain != nil {
+		m["domain"] = i.Domain
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,274 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndicatorValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func() *Indicator
+		expectedError string
+	}{
+		{
+			name: "valid number",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Value = 99.95
+				i.Number = &IndicatorNumber{Suffix: "%", ValueFormat: ".2f"}
+				return i
+			},
+			expectedError: "",
+		},
+		{
+			name: "valid number and relative delta",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeNumber + "+" + IndicatorModeDelta
+				i.Value = 420
+				i.Delta = &IndicatorDelta{
+					Reference:   Float64(400),
+					Relative:    Bool(true),
+					ValueFormat: ".1%",
+					Position:    DeltaPositionBottom,
+					Decreasing:  &DeltaDirection{Color: "green"},
+				}
+				return i
+			},
+			expectedError: "",
+		},
+		{
+			name: "valid gauge",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = "gauge+number"
+				i.Value = 182
+				i.Gauge = &Gauge{
+					Shape: GaugeShapeAngular,
+					Axis:  &GaugeAxis{Range: []float64{0, 300}},
+					Bar:   &GaugeBar{Color: "#636efa", Thickness: Float64(0.6)},
+					Steps: []GaugeStep{
+						{Range: []float64{0, 200}, Color: "#e5f5e0"},
+						{Range: []float64{200, 300}, Color: "#fee0d2"},
+					},
+					Threshold: &GaugeThreshold{Value: 250, Line: &GaugeLine{Color: "red", Width: 3}},
+				}
+				return i
+			},
+			expectedError: "",
+		},
+		{
+			name: "steps without a gauge range",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeGauge
+				i.Gauge = &Gauge{Steps: []GaugeStep{{Range: []float64{0, 1000}}}}
+				return i
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid mode",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = "number+sparkline"
+				return i
+			},
+			expectedError: "invalid mode flag: sparkline",
+		},
+		{
+			name: "repeated mode",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = "number+number"
+				return i
+			},
+			expectedError: "mode flag number is repeated",
+		},
+		{
+			name: "delta settings without delta mode",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Delta = &IndicatorDelta{Reference: Float64(1)}
+				return i
+			},
+			expectedError: "delta settings require the delta mode, got mode number",
+		},
+		{
+			name: "gauge settings without gauge mode",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = "number+delta"
+				i.Delta = &IndicatorDelta{Reference: Float64(1)}
+				i.Gauge = &Gauge{Shape: GaugeShapeBullet}
+				return i
+			},
+			expectedError: "gauge settings require the gauge mode, got mode number+delta",
+		},
+		{
+			name: "delta mode without reference",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeDelta
+				return i
+			},
+			expectedError: "a reference must be provided with the delta mode",
+		},
+		{
+			name: "relative delta with zero reference",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeDelta
+				i.Delta = &IndicatorDelta{Reference: Float64(0), Relative: Bool(true)}
+				return i
+			},
+			expectedError: "a relative delta requires a non-zero reference",
+		},
+		{
+			name: "invalid delta position",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeDelta
+				i.Delta = &IndicatorDelta{Reference: Float64(1), Position: "middle"}
+				return i
+			},
+			expectedError: "invalid delta position: middle",
+		},
+		{
+			name: "invalid gauge shape",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeGauge
+				i.Gauge = &Gauge{Shape: "radial"}
+				return i
+			},
+			expectedError: "invalid gauge shape: radial",
+		},
+		{
+			name: "invalid gauge range",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeGauge
+				i.Gauge = &Gauge{Axis: &GaugeAxis{Range: []float64{100, 0}}}
+				return i
+			},
+			expectedError: "range must be a [min, max] range of values",
+		},
+		{
+			name: "negative gauge tick width",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeGauge
+				i.Gauge = &Gauge{Axis: &GaugeAxis{TickWidth: -1}}
+				return i
+			},
+			expectedError: "validation error for Gauge.Axis.TickWidth: tick width must be non-negative",
+		},
+		{
+			name: "negative gauge number of ticks",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeGauge
+				i.Gauge = &Gauge{Axis: &GaugeAxis{NTicks: -1}}
+				return i
+			},
+			expectedError: "validation error for Gauge.Axis.NTicks: number of ticks must be non-negative",
+		},
+		{
+			name: "step outside gauge range",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeGauge
+				i.Gauge = &Gauge{
+					Axis:  &GaugeAxis{Range: []float64{0, 100}},
+					Steps: []GaugeStep{{Range: []float64{0, 50}}, {Range: []float64{50, 120}}},
+				}
+				return i
+			},
+			expectedError: "step range [50, 120] is outside the gauge range [0, 100]",
+		},
+		{
+			name: "invalid step range",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeGauge
+				i.Gauge = &Gauge{Steps: []GaugeStep{{Range: []float64{50}}}}
+				return i
+			},
+			expectedError: "step range must be a [start, end] range of values",
+		},
+		{
+			name: "threshold outside gauge range",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeGauge
+				i.Gauge = &Gauge{
+					Axis:      &GaugeAxis{Range: []float64{0, 100}},
+					Threshold: &GaugeThreshold{Value: 150},
+				}
+				return i
+			},
+			expectedError: "threshold 150 is outside the gauge range [0, 100]",
+		},
+		{
+			name: "invalid bar thickness",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Mode = IndicatorModeGauge
+				i.Gauge = &Gauge{Bar: &GaugeBar{Thickness: Float64(1.5)}}
+				return i
+			},
+			expectedError: "thickness must be between 0 and 1",
+		},
+		{
+			name: "invalid title alignment",
+			setup: func() *Indicator {
+				i := NewIndicator()
+				i.Title = &IndicatorTitle{Text: "Uptime", Align: "justify"}
+				return i
+			},
+			expectedError: "invalid alignment: justify",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.setup().Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestIndicatorMarshalJSON(t *testing.T) {
+	i := NewIndicator()
+	i.Mode = "number+delta+gauge"
+	i.Value = 182
+	i.Title = &IndicatorTitle{Text: "p99 latency"}
+	i.Number = &IndicatorNumber{Suffix: " ms"}
+	i.Delta = &IndicatorDelta{Reference: Float64(200)}
+	i.Gauge = &Gauge{
+		Axis:      &GaugeAxis{Range: []float64{0, 300}},
+		Steps:     []GaugeStep{{Range: []float64{200, 300}, Color: "#fee0d2"}},
+		Threshold: &GaugeThreshold{Value: 250, Thickness: Float64(0.75)},
+	}
+
+	data, err := json.Marshal(i)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"indicator"`)
+	assert.Contains(t, jsonStr, `"mode":"number+delta+gauge"`)
+	assert.Contains(t, jsonStr, `"value":182`)
+	assert.Contains(t, jsonStr, `"title":{"text":"p99 latency"}`)
+	assert.Contains(t, jsonStr, `"number":{"suffix":" ms"}`)
+	assert.Contains(t, jsonStr, `"delta":{"reference":200}`)
+	assert.Contains(t, jsonStr, `"gauge":{"axis":{"range":[0,300]},"steps":[{"range":[200,300],"color":"#fee0d2"}],"threshold":{"value":250,"thickness":0.75}}`)
+}
@@ -0,0 +1,592 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// BarMode represents how bars at the same location are drawn
+type BarMode string
+
+const (
+	BarModeStack    BarMode = "stack"
+	BarModeGroup    BarMode = "group"
+	BarModeOverlay  BarMode = "overlay"
+	BarModeRelative BarMode = "relative"
+)
+
+// BoxMode represents how boxes at the same location are drawn
+type BoxMode string
+
+const (
+	BoxModeGroup   BoxMode = "group"
+	BoxModeOverlay BoxMode = "overlay"
+)
+
+// HoverMode represents the hover interaction mode of a layout
+type HoverMode string
+
+const (
+	HoverModeX        HoverMode = "x"
+	HoverModeY        HoverMode = "y"
+	HoverModeClosest  HoverMode = "closest"
+	HoverModeXUnified HoverMode = "x unified"
+	HoverModeYUnified HoverMode = "y unified"
+)
+
+// AxisType represents the type of a cartesian axis
+type AxisType string
+
+const (
+	AxisTypeAuto          AxisType = "-"
+	AxisTypeLinear        AxisType = "linear"
+	AxisTypeLog           AxisType = "log"
+	AxisTypeDate          AxisType = "date"
+	AxisTypeCategory      AxisType = "category"
+	AxisTypeMultiCategory AxisType = "multicategory"
+)
+
+// LegendOrientation represents the orientation of the legend
+type LegendOrientation string
+
+const (
+	LegendOrientationVertical   LegendOrientation = "v"
+	LegendOrientationHorizontal LegendOrientation = "h"
+)
+
+// Layout represents the layout of a figure
+type Layout struct {
+	// Title and Fonts
+	Title *Title `json:"title,omitempty"`
+	Font  *Font  `json:"font,omitempty"`
+
+	// Sizing Properties
+	Width    float64 `json:"width,omitempty"`
+	Height   float64 `json:"height,omitempty"`
+	AutoSize *bool   `json:"autosize,omitempty"`
+	Margin   *Margin `json:"margin,omitempty"`
+
+	// Axes
+	XAxis *Axis `json:"xaxis,omitempty"`
+	YAxis *Axis `json:"yaxis,omitempty"`
+
+	// 3D Scene
+	Scene *Scene `json:"scene,omitempty"`
+
+	// Polar Subplot
+	Polar *Polar `json:"polar,omitempty"`
+
+	// Geographic Map
+	Geo *Geo `json:"geo,omitempty"`
+
+	// Legend Properties
+	ShowLegend *bool   `json:"showlegend,omitempty"`
+	Legend     *Legend `json:"legend,omitempty"`
+
+	// Bar and Box Properties
+	BarMode     string   `json:"barmode,omitempty"`
+	BarGap      *float64 `json:"bargap,omitempty"`
+	BarGroupGap *float64 `json:"bargroupgap,omitempty"`
+	BoxMode     string   `json:"boxmode,omitempty"`
+	BoxGap      *float64 `json:"boxgap,omitempty"`
+	BoxGroupGap *float64 `json:"boxgroupgap,omitempty"`
+
+	// Interactive Properties
+	HoverMode  interface{} `json:"hovermode,omitempty"` // string or false
+	HoverLabel *HoverLabel `json:"hoverlabel,omitempty"`
+	DragMode   string      `json:"dragmode,omitempty"`
+	ClickMode  string      `json:"clickmode,omitempty"`
+
+	// Color Properties
+	PaperBgColor string   `json:"paper_bgcolor,omitempty"`
+	PlotBgColor  string   `json:"plot_bgcolor,omitempty"`
+	Colorway     []string `json:"colorway,omitempty"`
+
+	// Decorations
+	Annotations interface{} `json:"annotations,omitempty"`
+	Shapes      interface{} `json:"shapes,omitempty"`
+	Template    interface{} `json:"template,omitempty"`
+
+	Extra map[string]interface{} `json:"-"` // for additional properties (e.g. xaxis2)
+}
+
+// Title represents a layout or axis title
+type Title struct {
+	Text     string   `json:"text,omitempty"`
+	Font     *Font    `json:"font,omitempty"`
+	X        *float64 `json:"x,omitempty"`
+	Y        *float64 `json:"y,omitempty"`
+	XAnchor  string   `json:"xanchor,omitempty"`
+	YAnchor  string   `json:"yanchor,omitempty"`
+	Standoff float64  `json:"standoff,omitempty"`
+}
+
+// Axis represents a cartesian axis
+type Axis struct {
+	Title          *Title        `json:"title,omitempty"`
+	Type           string        `json:"type,omitempty"`
+	Range          []interface{} `json:"range,omitempty"`
+	AutoRange      interface{}   `json:"autorange,omitempty"` // bool or "reversed"
+	AutoMargin     *bool         `json:"automargin,omitempty"`
+	Domain         []float64     `json:"domain,omitempty"`
+	Anchor         string        `json:"anchor,omitempty"`
+	Overlaying     string        `json:"overlaying,omitempty"`
+	Side           string        `json:"side,omitempty"`
+	ShowGrid       *bool         `json:"showgrid,omitempty"`
+	GridColor      string        `json:"gridcolor,omitempty"`
+	GridWidth      float64       `json:"gridwidth,omitempty"`
+	ZeroLine       *bool         `json:"zeroline,omitempty"`
+	ZeroLineColor  string        `json:"zerolinecolor,omitempty"`
+	ZeroLineWidth  float64       `json:"zerolinewidth,omitempty"`
+	ShowLine       *bool         `json:"showline,omitempty"`
+	LineColor      string        `json:"linecolor,omitempty"`
+	LineWidth      float64       `json:"linewidth,omitempty"`
+	TickAngle      interface{}   `json:"tickangle,omitempty"` // number or "auto"
+	TickFormat     string        `json:"tickformat,omitempty"`
+	TickPrefix     string        `json:"tickprefix,omitempty"`
+	TickSuffix     string        `json:"ticksuffix,omitempty"`
+	TickFont       *Font         `json:"tickfont,omitempty"`
+	TickVals       interface{}   `json:"tickvals,omitempty"`
+	TickText       interface{}   `json:"ticktext,omitempty"`
+	DTick          interface{}   `json:"dtick,omitempty"`
+	NTicks         int           `json:"nticks,omitempty"`
+	ShowTickLabels *bool         `json:"showticklabels,omitempty"`
+	RangeSlider    *RangeSlider  `json:"rangeslider,omitempty"`
+}
+
+// RangeSlider represents the range slider of an x axis
+type RangeSlider struct {
+	Visible *bool `json:"visible,omitempty"`
+}
+
+// Legend represents legend properties
+type Legend struct {
+	Title       *Title   `json:"title,omitempty"`
+	Font        *Font    `json:"font,omitempty"`
+	Orientation string   `json:"orientation,omitempty"`
+	X           *float64 `json:"x,omitempty"`
+	Y           *float64 `json:"y,omitempty"`
+	XAnchor     string   `json:"xanchor,omitempty"`
+	YAnchor     string   `json:"yanchor,omitempty"`
+	BgColor     string   `json:"bgcolor,omitempty"`
+	BorderColor string   `json:"bordercolor,omitempty"`
+	BorderWidth float64  `json:"borderwidth,omitempty"`
+	TraceOrder  string   `json:"traceorder,omitempty"`
+}
+
+// Margin represents the figure margins in pixels
+type Margin struct {
+	L          float64 `json:"l,omitempty"`
+	R          float64 `json:"r,omitempty"`
+	T          float64 `json:"t,omitempty"`
+	B          float64 `json:"b,omitempty"`
+	Pad        float64 `json:"pad,omitempty"`
+	AutoExpand *bool   `json:"autoexpand,omitempty"`
+}
+
+// NewLayout creates a new, empty layout
+func NewLayout() *Layout {
+	return &Layout{}
+}
+
+// UnmarshalJSON accepts either a plain string or a title object
+func (t *Title) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		t.Text = text
+		return nil
+	}
+
+	type title Title
+	return json.Unmarshal(data, (*title)(t))
+}
+
+// Validate implements the Validator interface
+func (l *Layout) Validate() error {
+	// Validate sizing
+	if l.Width != 0 && l.Width < 10 {
+		return &validation.ValidationError{
+			Field:   "Width",
+			Message: "width must be at least 10",
+		}
+	}
+	if l.Height != 0 && l.Height < 10 {
+		return &validation.ValidationError{
+			Field:   "Height",
+			Message: "height must be at least 10",
+		}
+	}
+
+	// Validate bar mode
+	if l.BarMode != "" {
+		validBarModes := map[string]bool{
+			string(BarModeStack):    true,
+			string(BarModeGroup):    true,
+			string(BarModeOverlay):  true,
+			string(BarModeRelative): true,
+		}
+		if !validBarModes[l.BarMode] {
+			return &validation.ValidationError{
+				Field:   "BarMode",
+				Message: fmt.Sprintf("invalid bar mode: %s", l.BarMode),
+			}
+		}
+	}
+
+	// Validate box mode
+	if l.BoxMode != "" {
+		validBoxModes := map[string]bool{
+			string(BoxModeGroup):   true,
+			string(BoxModeOverlay): true,
+		}
+		if !validBoxModes[l.BoxMode] {
+			return &validation.ValidationError{
+				Field:   "BoxMode",
+				Message: fmt.Sprintf("invalid box mode: %s", l.BoxMode),
+			}
+		}
+	}
+
+	// Validate gaps
+	gaps := []struct {
+		field string
+		value *float64
+	}{
+		{"BarGap", l.BarGap},
+		{"BarGroupGap", l.BarGroupGap},
+		{"BoxGap", l.BoxGap},
+		{"BoxGroupGap", l.BoxGroupGap},
+	}
+	for _, gap := range gaps {
+		if gap.value != nil && (*gap.value < 0 || *gap.value > 1) {
+			return &validation.ValidationError{
+				Field:   gap.field,
+				Message: "gap must be between 0 and 1",
+			}
+		}
+	}
+
+	// Validate hover mode
+	if l.HoverMode != nil {
+		switch v := l.HoverMode.(type) {
+		case bool:
+			if v {
+				return &validation.ValidationError{
+					Field:   "HoverMode",
+					Message: "hover mode can only be disabled with false",
+				}
+			}
+		case string:
+			validHoverModes := map[string]bool{
+				string(HoverModeX):        true,
+				string(HoverModeY):        true,
+				string(HoverModeClosest):  true,
+				string(HoverModeXUnified): true,
+				string(HoverModeYUnified): true,
+			}
+			if !validHoverModes[v] {
+				return &validation.ValidationError{
+					Field:   "HoverMode",
+					Message: fmt.Sprintf("invalid hover mode: %s", v),
+				}
+			}
+		default:
+			return &validation.ValidationError{
+				Field:   "HoverMode",
+				Message: "hover mode must be a string or false",
+			}
+		}
+	}
+
+	// Validate margins
+	if l.Margin != nil {
+		if err := l.validateMargin(); err != nil {
+			return err
+		}
+	}
+
+	// Validate axes
+	if l.XAxis != nil {
+		if err := l.validateAxis(l.XAxis, "XAxis"); err != nil {
+			return err
+		}
+	}
+	if l.YAxis != nil {
+		if err := l.validateAxis(l.YAxis, "YAxis"); err != nil {
+			return err
+		}
+	}
+
+	// Validate scene
+	if l.Scene != nil {
+		if err := l.Scene.validate("Scene"); err != nil {
+			return err
+		}
+	}
+
+	// Validate polar subplot
+	if l.Polar != nil {
+		if err := l.Polar.validate("Polar"); err != nil {
+			return err
+		}
+	}
+
+	// Validate geographic map
+	if l.Geo != nil {
+		if err := l.Geo.validate("Geo"); err != nil {
+			return err
+		}
+	}
+
+	// Validate legend
+	if l.Legend != nil {
+		if err := l.validateLegend(); err != nil {
+			return err
+		}
+	}
+
+	// Validate fonts
+	if err := validateLayoutFont(l.Font, "Font"); err != nil {
+		return err
+	}
+	if l.Title != nil {
+		if err := validateLayoutFont(l.Title.Font, "Title.Font"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *Layout) validateMargin() error {
+	m := l.Margin
+	sides := []struct {
+		field string
+		value float64
+	}{
+		{"Margin.L", m.L},
+		{"Margin.R", m.R},
+		{"Margin.T", m.T},
+		{"Margin.B", m.B},
+		{"Margin.Pad", m.Pad},
+	}
+	for _, side := range sides {
+		if side.value < 0 {
+			return &validation.ValidationError{
+				Field:   side.field,
+				Message: "margin must be non-negative",
+			}
+		}
+	}
+	return nil
+}
+
+func (l *Layout) validateAxis(a *Axis, field string) error {
+	if a.Type != "" {
+		validTypes := map[string]bool{
+			string(AxisTypeAuto):          true,
+			string(AxisTypeLinear):        true,
+			string(AxisTypeLog):           true,
+			string(AxisTypeDate):          true,
+			string(AxisTypeCategory):      true,
+			string(AxisTypeMultiCategory): true,
+		}
+		if !validTypes[a.Type] {
+			return &validation.ValidationError{
+				Field:   field + ".Type",
+				Message: fmt.Sprintf("invalid axis type: %s", a.Type),
+			}
+		}
+	}
+
+	if a.Range != nil && len(a.Range) != 2 {
+		return &validation.ValidationError{
+			Field:   field + ".Range",
+			Message: "range must have exactly two values",
+		}
+	}
+
+	if err := validateAxisLines(field, a.GridWidth, a.LineWidth, a.NTicks); err != nil {
+		return err
+	}
+
+	if a.Title != nil {
+		if err := validateLayoutFont(a.Title.Font, field+".Title.Font"); err != nil {
+			return err
+		}
+	}
+
+	return validateLayoutFont(a.TickFont, field+".TickFont")
+}
+
+// validateAxisLines checks the grid and line widths and number of ticks of
+// cartesian, scene and polar axes
+func validateAxisLines(field string, gridWidth, lineWidth float64, nticks int) error {
+	if gridWidth < 0 {
+		return &validation.ValidationError{
+			Field:   field + ".GridWidth",
+			Message: "grid width must be non-negative",
+		}
+	}
+
+	if lineWidth < 0 {
+		return &validation.ValidationError{
+			Field:   field + ".LineWidth",
+			Message: "line width must be non-negative",
+		}
+	}
+
+	if nticks < 0 {
+		return &validation.ValidationError{
+			Field:   field + ".NTicks",
+			Message: "number of ticks must be non-negative",
+		}
+	}
+	return nil
+}
+
+func (l *Layout) validateLegend() error {
+	lg := l.Legend
+
+	if lg.Orientation != "" {
+		validOrientations := map[string]bool{
+			string(LegendOrientationVertical):   true,
+			string(LegendOrientationHorizontal): true,
+		}
+		if !validOrientations[lg.Orientation] {
+			return &validation.ValidationError{
+				Field:   "Legend.Orientation",
+				Message: fmt.Sprintf("invalid legend orientation: %s", lg.Orientation),
+			}
+		}
+	}
+
+	if lg.BorderWidth < 0 {
+		return &validation.ValidationError{
+			Field:   "Legend.BorderWidth",
+			Message: "border width must be non-negative",
+		}
+	}
+
+	return validateLayoutFont(lg.Font, "Legend.Font")
+}
+
+func validateLayoutFont(f *Font, field string) error {
+	if f != nil && f.Size < 0 {
+		return &validation.ValidationError{
+			Field:   field + ".Size",
+			Message: "font size must be non-negative",
+		}
+	}
+	return nil
+}
+
+// Update merges the provided values into the layout. Keys are plotly layout
+// attribute names; nested objects are merged into existing ones and unknown
+// keys are kept in Extra.
+func (l *Layout) Update(updates map[string]interface{}) error {
+	data, err := json.Marshal(updates)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, l)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (l *Layout) MarshalJSON() ([]byte, error) {
+	type layout Layout
+	data, err := json.Marshal((*layout)(l))
+	if err != nil {
+		return nil, err
+	}
+	if len(l.Extra) == 0 {
+		return data, nil
+	}
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for k, v := range l.Extra {
+		if _, exists := m[k]; !exists {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Attributes without
+// a typed field are kept in Extra.
+func (l *Layout) UnmarshalJSON(data []byte) error {
+	type layout Layout
+	if err := json.Unmarshal(data, (*layout)(l)); err != nil {
+		return err
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	known := layoutAttributes()
+	for k, raw := range m {
+		if known[k] {
+			continue
+		}
+		var v interface{}
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return err
+		}
+		if l.Extra == nil {
+			l.Extra = make(map[string]interface{})
+		}
+		l.Extra[k] = v
+	}
+	return nil
+}
+
+// layoutAttributes returns the set of attribute names with a typed Layout field
+func layoutAttributes() map[string]bool {
+	return structAttributes(reflect.TypeOf(Layout{}))
+}
+
+// structAttributes returns the set of attribute names with a typed field in
+// the struct type, including the fields of embedded structs
+func structAttributes(t reflect.Type) map[string]bool {
+	known := make(map[string]bool)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			for name := range structAttributes(f.Type) {
+				known[name] = true
+			}
+			continue
+		}
+		name := JSONFieldName(f)
+		if name != "" {
+			known[name] = true
+		}
+	}
+	return known
+}
+
+// JSONFieldName returns the JSON attribute name of a struct field, or "" if
+// the field is not serialized
+func JSONFieldName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	if tag == "-" || f.PkgPath != "" {
+		return ""
+	}
+	for i := 0; i < len(tag); i++ {
+		if tag[i] == ',' {
+			tag = tag[:i]
+			break
+		}
+	}
+	if tag == "" {
+		return f.Name
+	}
+	return tag
+}
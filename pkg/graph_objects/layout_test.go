@@ -0,0 +1,162 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayoutValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		layout  *Layout
+		wantErr bool
+	}{
+		{
+			name:    "empty layout",
+			layout:  NewLayout(),
+			wantErr: false,
+		},
+		{
+			name: "valid layout",
+			layout: &Layout{
+				Title:   &Title{Text: "Sales"},
+				BarMode: string(BarModeStack),
+				BarGap:  Float64(0.15),
+				XAxis:   &Axis{Title: &Title{Text: "Products"}, TickAngle: -45},
+				Margin:  &Margin{L: 100, R: 20, T: 70, B: 70},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid bar mode",
+			layout:  &Layout{BarMode: "stacked"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid box mode",
+			layout:  &Layout{BoxMode: "stack"},
+			wantErr: true,
+		},
+		{
+			name:    "bar gap out of range",
+			layout:  &Layout{BarGap: Float64(1.5)},
+			wantErr: true,
+		},
+		{
+			name:    "hover mode disabled",
+			layout:  &Layout{HoverMode: false},
+			wantErr: false,
+		},
+		{
+			name:    "invalid hover mode",
+			layout:  &Layout{HoverMode: "nearest"},
+			wantErr: true,
+		},
+		{
+			name:    "width too small",
+			layout:  &Layout{Width: 5},
+			wantErr: true,
+		},
+		{
+			name:    "negative margin",
+			layout:  &Layout{Margin: &Margin{L: -1}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid axis type",
+			layout:  &Layout{YAxis: &Axis{Type: "logarithmic"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid axis range",
+			layout:  &Layout{XAxis: &Axis{Range: []interface{}{0}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid legend orientation",
+			layout:  &Layout{Legend: &Legend{Orientation: "diagonal"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.layout.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Layout.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLayoutMarshalJSON(t *testing.T) {
+	layout := &Layout{
+		Title:        &Title{Text: "Sales", Font: &Font{Size: 24}},
+		BarMode:      string(BarModeGroup),
+		BarGap:       Float64(0),
+		ShowLegend:   Bool(true),
+		PlotBgColor:  "white",
+		PaperBgColor: "white",
+		Extra: map[string]interface{}{
+			"xaxis2": map[string]interface{}{"overlaying": "x"},
+		},
+	}
+
+	data, err := json.Marshal(layout)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"text":"Sales"`)
+	assert.Contains(t, jsonStr, `"font":{"size":24}`)
+	assert.Contains(t, jsonStr, `"barmode":"group"`)
+	assert.Contains(t, jsonStr, `"bargap":0`)
+	assert.Contains(t, jsonStr, `"showlegend":true`)
+	assert.Contains(t, jsonStr, `"plot_bgcolor":"white"`)
+	assert.Contains(t, jsonStr, `"xaxis2":{"overlaying":"x"}`)
+}
+
+func TestLayoutUnmarshalJSON(t *testing.T) {
+	data := []byte(`{
+		"title": "Sales",
+		"xaxis": {"title": "Products", "tickangle": -45},
+		"barmode": "stack",
+		"annotations": [{"text": "note"}],
+		"yaxis2": {"side": "right"}
+	}`)
+
+	var layout Layout
+	assert.NoError(t, json.Unmarshal(data, &layout))
+
+	assert.Equal(t, "Sales", layout.Title.Text)
+	assert.Equal(t, "Products", layout.XAxis.Title.Text)
+	assert.Equal(t, float64(-45), layout.XAxis.TickAngle)
+	assert.Equal(t, string(BarModeStack), layout.BarMode)
+	assert.NotNil(t, layout.Annotations)
+	assert.Equal(t, map[string]interface{}{"side": "right"}, layout.Extra["yaxis2"])
+	assert.NotContains(t, layout.Extra, "barmode")
+}
+
+func TestLayoutUpdate(t *testing.T) {
+	layout := &Layout{
+		Title: &Title{Text: "Sales", Font: &Font{Size: 24}},
+		XAxis: &Axis{GridColor: "#E1E1E1"},
+	}
+
+	err := layout.Update(map[string]interface{}{
+		"title":   "Revenue",
+		"xaxis":   map[string]interface{}{"tickangle": -45},
+		"barmode": "group",
+		"xaxis2":  map[string]interface{}{"overlaying": "x"},
+	})
+	assert.NoError(t, err)
+
+	// Nested objects are merged rather than replaced
+	assert.Equal(t, "Revenue", layout.Title.Text)
+	assert.Equal(t, float64(24), layout.Title.Font.Size)
+	assert.Equal(t, "#E1E1E1", layout.XAxis.GridColor)
+	assert.Equal(t, float64(-45), layout.XAxis.TickAngle)
+	assert.Equal(t, string(BarModeGroup), layout.BarMode)
+	assert.Contains(t, layout.Extra, "xaxis2")
+}
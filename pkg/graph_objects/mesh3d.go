@@ -0,0 +1,274 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Mesh3d intensity modes
+const (
+	IntensityModeVertex = "vertex"
+	IntensityModeCell   = "cell"
+)
+
+// Mesh3d represents a 3D mesh trace, drawn as triangles between vertices in a
+// scene. Without faces plotly triangulates the vertices itself, using
+// AlphaHull and DelaunayAxis.
+type Mesh3d struct {
+	BaseTrace
+	// Vertices
+	X []float64 `json:"x,omitempty"`
+	Y []float64 `json:"y,omitempty"`
+	Z []float64 `json:"z,omitempty"`
+
+	// Faces, as indices of the three vertices of each triangle
+	I []int `json:"i,omitempty"`
+	J []int `json:"j,omitempty"`
+	K []int `json:"k,omitempty"`
+
+	// Triangulation Properties
+	AlphaHull    *float64 `json:"alphahull,omitempty"`    // -1 for Delaunay, 0 for the convex hull
+	DelaunayAxis string   `json:"delaunayaxis,omitempty"` // "x", "y" or "z"
+
+	// Color Properties
+	Color         string      `json:"color,omitempty"`
+	VertexColor   interface{} `json:"vertexcolor,omitempty"` // one color per vertex
+	FaceColor     interface{} `json:"facecolor,omitempty"`   // one color per face
+	Intensity     []float64   `json:"intensity,omitempty"`   // values colored with ColorScale
+	IntensityMode string      `json:"intensitymode,omitempty"`
+	ColorScale    interface{} `json:"colorscale,omitempty"` // name or [position, color] pairs
+	ReverseScale  *bool       `json:"reversescale,omitempty"`
+	ShowScale     *bool       `json:"showscale,omitempty"`
+	CMin          *float64    `json:"cmin,omitempty"`
+	CMax          *float64    `json:"cmax,omitempty"`
+	CMid          *float64    `json:"cmid,omitempty"`
+	ColorBar      *ColorBar   `json:"colorbar,omitempty"`
+
+	// Shading Properties
+	FlatShading *bool     `json:"flatshading,omitempty"`
+	Lighting    *Lighting `json:"lighting,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	Scene       string `json:"scene,omitempty"` // e.g. "scene" or "scene2"
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// NewMesh3d creates a new 3D mesh trace
+func NewMesh3d() *Mesh3d {
+	return &Mesh3d{
+		BaseTrace: BaseTrace{
+			Type: "mesh3d",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (m *Mesh3d) Validate() error {
+	if err := m.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	// Validate vertices
+	if len(m.X) == 0 || len(m.Y) == 0 || len(m.Z) == 0 {
+		return &validation.ValidationError{
+			Field:   "X/Y/Z",
+			Message: "X, Y and Z must be provided",
+		}
+	}
+	if err := validateCoordinateLengths(m.X, m.Y, m.Z); err != nil {
+		return err
+	}
+	vertices := len(m.X)
+
+	// Validate faces
+	if len(m.I) != len(m.J) || len(m.I) != len(m.K) {
+		return &validation.ValidationError{
+			Field:   "I/J/K",
+			Message: fmt.Sprintf("i, j and k must have the same length (%d, %d, %d)", len(m.I), len(m.J), len(m.K)),
+		}
+	}
+	faces := len(m.I)
+	indices := []struct {
+		field  string
+		values []int
+	}{
+		{"I", m.I},
+		{"J", m.J},
+		{"K", m.K},
+	}
+	for _, idx := range indices {
+		for i, vertex := range idx.values {
+			if vertex < 0 || vertex >= vertices {
+				return &validation.ValidationError{
+					Field:   idx.field,
+					Message: fmt.Sprintf("vertex index %d of face %d is out of range for %d vertices", vertex, i, vertices),
+				}
+			}
+		}
+	}
+
+	if m.DelaunayAxis != "" && m.DelaunayAxis != "x" && m.DelaunayAxis != "y" && m.DelaunayAxis != "z" {
+		return &validation.ValidationError{
+			Field:   "DelaunayAxis",
+			Message: fmt.Sprintf("invalid delaunay axis: %s", m.DelaunayAxis),
+		}
+	}
+
+	// Validate per vertex and per face colors
+	if length, ok := arrayLength(m.VertexColor); ok && length != vertices {
+		return &validation.ValidationError{
+			Field:   "VertexColor",
+			Message: fmt.Sprintf("%d vertex colors given for %d vertices", length, vertices),
+		}
+	}
+	if length, ok := arrayLength(m.FaceColor); ok && length != faces {
+		return &validation.ValidationError{
+			Field:   "FaceColor",
+			Message: fmt.Sprintf("%d face colors given for %d faces", length, faces),
+		}
+	}
+
+	// Intensities are given per vertex, or per face in cell mode
+	if m.IntensityMode != "" && m.IntensityMode != IntensityModeVertex && m.IntensityMode != IntensityModeCell {
+		return &validation.ValidationError{
+			Field:   "IntensityMode",
+			Message: fmt.Sprintf("invalid intensity mode: %s", m.IntensityMode),
+		}
+	}
+	if m.Intensity != nil {
+		expected, unit := vertices, "vertices"
+		if m.IntensityMode == IntensityModeCell {
+			expected, unit = faces, "faces"
+		}
+		if len(m.Intensity) != expected {
+			return &validation.ValidationError{
+				Field:   "Intensity",
+				Message: fmt.Sprintf("%d intensities given for %d %s", len(m.Intensity), expected, unit),
+			}
+		}
+	}
+
+	if m.Lighting != nil {
+		if err := m.Lighting.validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateColorScale("ColorScale", m.ColorScale); err != nil {
+		return err
+	}
+	return validateColorRange("CMin", m.CMin, "CMax", m.CMax)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (m *Mesh3d) MarshalJSON() ([]byte, error) {
+	out := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&m.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &out); err != nil {
+		return nil, err
+	}
+
+	// Always include type and vertices
+	out["type"] = "mesh3d"
+	out["x"] = m.X
+	out["y"] = m.Y
+	out["z"] = m.Z
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			out[key] = value
+		}
+	}
+
+	if m.I != nil {
+		out["i"] = m.I
+	}
+	if m.J != nil {
+		out["j"] = m.J
+	}
+	if m.K != nil {
+		out["k"] = m.K
+	}
+
+	// Triangulation Properties
+	if m.AlphaHull != nil {
+		out["alphahull"] = *m.AlphaHull
+	}
+	if m.DelaunayAxis != "" {
+		out["delaunayaxis"] = m.DelaunayAxis
+	}
+
+	// Color Properties
+	if m.Color != "" {
+		out["color"] = m.Color
+	}
+	addIfNotEmpty("vertexcolor", m.VertexColor)
+	addIfNotEmpty("facecolor", m.FaceColor)
+	if m.Intensity != nil {
+		out["intensity"] = m.Intensity
+	}
+	if m.IntensityMode != "" {
+		out["intensitymode"] = m.IntensityMode
+	}
+	addIfNotEmpty("colorscale", m.ColorScale)
+	if m.ReverseScale != nil {
+		out["reversescale"] = *m.ReverseScale
+	}
+	if m.ShowScale != nil {
+		out["showscale"] = *m.ShowScale
+	}
+	if m.CMin != nil {
+		out["cmin"] = *m.CMin
+	}
+	if m.CMax != nil {
+		out["cmax"] = *m.CMax
+	}
+	if m.CMid != nil {
+		out["cmid"] = *m.CMid
+	}
+	if m.ColorBar != nil {
+		out["colorbar"] = m.ColorBar
+	}
+
+	// Shading Properties
+	if m.FlatShading != nil {
+		out["flatshading"] = *m.FlatShading
+	}
+	if m.Lighting != nil {
+		out["lighting"] = m.Lighting
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", m.Text)
+	addIfNotEmpty("hovertext", m.HoverText)
+	if m.HoverTemplate != "" {
+		out["hovertemplate"] = m.HoverTemplate
+	}
+	if m.HoverLabel != nil {
+		out["hoverlabel"] = m.HoverLabel
+	}
+
+	// Layout Properties
+	if m.Scene != "" {
+		out["scene"] = m.Scene
+	}
+	if m.LegendGroup != "" {
+		out["legendgroup"] = m.LegendGroup
+	}
+
+	return json.Marshal(out)
+}
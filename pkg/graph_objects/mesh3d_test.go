@@ -0,0 +1,139 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMesh3dValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Mesh3d)
+		expectedError string
+	}{
+		{
+			name:          "valid mesh",
+			setup:         func(m *Mesh3d) {},
+			expectedError: "",
+		},
+		{
+			name: "missing vertices",
+			setup: func(m *Mesh3d) {
+				m.Z = nil
+			},
+			expectedError: "X, Y and Z must be provided",
+		},
+		{
+			name: "vertex coordinate mismatch",
+			setup: func(m *Mesh3d) {
+				m.Y = []float64{0, 0, 1}
+			},
+			expectedError: "Y has 3 values, expected 4",
+		},
+		{
+			name: "vertices without faces",
+			setup: func(m *Mesh3d) {
+				m.I, m.J, m.K = nil, nil, nil
+				m.AlphaHull = Float64(0)
+			},
+			expectedError: "",
+		},
+		{
+			name: "face index length mismatch",
+			setup: func(m *Mesh3d) {
+				m.K = []int{2, 3}
+			},
+			expectedError: "i, j and k must have the same length (4, 4, 2)",
+		},
+		{
+			name: "face index out of range",
+			setup: func(m *Mesh3d) {
+				m.J = []int{1, 2, 4, 2}
+			},
+			expectedError: "vertex index 4 of face 2 is out of range for 4 vertices",
+		},
+		{
+			name: "negative face index",
+			setup: func(m *Mesh3d) {
+				m.I = []int{0, -1, 0, 1}
+			},
+			expectedError: "vertex index -1 of face 1 is out of range for 4 vertices",
+		},
+		{
+			name: "vertex intensity",
+			setup: func(m *Mesh3d) {
+				m.Intensity = []float64{0, 0.3, 0.6, 1}
+			},
+			expectedError: "",
+		},
+		{
+			name: "cell intensity per vertex",
+			setup: func(m *Mesh3d) {
+				m.Intensity = []float64{0, 0.3, 0.6}
+				m.IntensityMode = IntensityModeCell
+			},
+			expectedError: "3 intensities given for 4 faces",
+		},
+		{
+			name: "face color count mismatch",
+			setup: func(m *Mesh3d) {
+				m.FaceColor = []string{"red", "green"}
+			},
+			expectedError: "2 face colors given for 4 faces",
+		},
+		{
+			name: "invalid delaunay axis",
+			setup: func(m *Mesh3d) {
+				m.DelaunayAxis = "w"
+			},
+			expectedError: "invalid delaunay axis: w",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A tetrahedron
+			m := NewMesh3d()
+			m.X = []float64{0, 1, 0, 0}
+			m.Y = []float64{0, 0, 1, 0}
+			m.Z = []float64{0, 0, 0, 1}
+			m.I = []int{0, 0, 0, 1}
+			m.J = []int{1, 2, 3, 2}
+			m.K = []int{2, 3, 1, 3}
+			tt.setup(m)
+
+			err := m.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestMesh3dMarshalJSON(t *testing.T) {
+	m := NewMesh3d()
+	m.X = []float64{0, 1, 0}
+	m.Y = []float64{0, 0, 1}
+	m.Z = []float64{0, 0, 0}
+	m.I = []int{0}
+	m.J = []int{1}
+	m.K = []int{2}
+	m.Color = "lightblue"
+	m.FlatShading = Bool(true)
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"mesh3d"`)
+	assert.Contains(t, jsonStr, `"i":[0]`)
+	assert.Contains(t, jsonStr, `"k":[2]`)
+	assert.Contains(t, jsonStr, `"color":"lightblue"`)
+	assert.Contains(t, jsonStr, `"flatshading":true`)
+	assert.NotContains(t, jsonStr, `"alphahull"`)
+}
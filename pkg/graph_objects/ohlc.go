@@ -112,8 +112,53 @@ func (o *OHLC) Validate() error {
 		return err
 	}
 
+	if err := validateOHLCData(o.Open, o.High, o.Low, o.Close); err != nil {
+		return err
+	}
+
+	// Validate line properties
+	if o.Line != nil {
+		if err := o.validateLine(o.Line, "Line"); err != nil {
+			return err
+		}
+	}
+
+	// Validate increasing/decreasing properties
+	if o.Increasing != nil {
+		if err := o.validateDirection(o.Increasing, "Increasing"); err != nil {
+			return err
+		}
+	}
+	if o.Decreasing != nil {
+		if err := o.validateDirection(o.Decreasing, "Decreasing"); err != nil {
+			return err
+		}
+	}
+
+	// Validate opacity
+	if o.Opacity < 0 || o.Opacity > 1 {
+		return &validation.ValidationError{
+			Field:   "Opacity",
+			Message: "opacity must be between 0 and 1",
+		}
+	}
+
+	// Validate tick width
+	if o.TickWidth < 0 {
+		return &validation.ValidationError{
+			Field:   "TickWidth",
+			Message: "tick width must be non-negative",
+		}
+	}
+
+	return nil
+}
+
+// validateOHLCData validates the open, high, low and close values shared by
+// OHLC and candlestick traces
+func validateOHLCData(open, high, low, close interface{}) error {
 	// Validate required data fields
-	if o.Open == nil || o.High == nil || o.Low == nil || o.Close == nil {
+	if open == nil || high == nil || low == nil || close == nil {
 		return &validation.ValidationError{
 			Field:   "Open/High/Low/Close",
 			Message: "all OHLC values (open, high, low, close) must be provided",
@@ -121,32 +166,32 @@ func (o *OHLC) Validate() error {
 	}
 
 	// Validate that all data arrays have the same length
-	opens, ok := o.Open.([]float64)
+	opens, ok := toFloat64Slice(open)
 	if !ok {
 		return &validation.ValidationError{
 			Field:   "Open",
-			Message: "open values must be []float64",
+			Message: "open values must be an array of numbers",
 		}
 	}
-	highs, ok := o.High.([]float64)
+	highs, ok := toFloat64Slice(high)
 	if !ok {
 		return &validation.ValidationError{
 			Field:   "High",
-			Message: "high values must be []float64",
+			Message: "high values must be an array of numbers",
 		}
 	}
-	lows, ok := o.Low.([]float64)
+	lows, ok := toFloat64Slice(low)
 	if !ok {
 		return &validation.ValidationError{
 			Field:   "Low",
-			Message: "low values must be []float64",
+			Message: "low values must be an array of numbers",
 		}
 	}
-	closes, ok := o.Close.([]float64)
+	closes, ok := toFloat64Slice(close)
 	if !ok {
 		return &validation.ValidationError{
 			Field:   "Close",
-			Message: "close values must be []float64",
+			Message: "close values must be an array of numbers",
 		}
 	}
 
@@ -160,10 +205,7 @@ func (o *OHLC) Validate() error {
 
 	// Validate price relationships for each data point
 	for i := 0; i < length; i++ {
-		open := opens[i]
-		high := highs[i]
-		low := lows[i]
-		close := closes[i]
+		open, high, low, close := opens[i], highs[i], lows[i], closes[i]
 
 		// Check if open is between low and high
 		if open > high || open < low {
@@ -177,42 +219,6 @@ func (o *OHLC) Validate() error {
 		if low > high {
 			return fmt.Errorf("Data Point %d: low (%.2f) cannot be greater than high (%.2f)", i, low, high)
 		}
-
-	}
-
-	// Validate line properties
-	if o.Line != nil {
-		if err := o.validateLine(o.Line, "Line"); err != nil {
-			return err
-		}
-	}
-
-	// Validate increasing/decreasing properties
-	if o.Increasing != nil {
-		if err := o.validateDirection(o.Increasing, "Increasing"); err != nil {
-			return err
-		}
-	}
-	if o.Decreasing != nil {
-		if err := o.validateDirection(o.Decreasing, "Decreasing"); err != nil {
-			return err
-		}
-	}
-
-	// Validate opacity
-	if o.Opacity < 0 || o.Opacity > 1 {
-		return &validation.ValidationError{
-			Field:   "Opacity",
-			Message: "opacity must be between 0 and 1",
-		}
-	}
-
-	// Validate tick width
-	if o.TickWidth < 0 {
-		return &validation.ValidationError{
-			Field:   "TickWidth",
-			Message: "tick width must be non-negative",
-		}
 	}
 
 	return nil
@@ -258,7 +264,7 @@ func (o *OHLC) MarshalJSON() ([]byte, error) {
 	m := make(map[string]interface{})
 
 	// Add base trace fields
-	baseData, err := json.Marshal(o.BaseTrace)
+	baseData, err := json.Marshal(&o.BaseTrace)
 	if err != nil {
 		return nil, err
 	}
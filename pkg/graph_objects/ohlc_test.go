@@ -161,7 +161,7 @@ func TestOHLCInvalidTypes(t *testing.T) {
 				o.Low = []float64{32.0}
 				o.Close = []float64{33.5}
 			},
-			expectedError: "open values must be []float64",
+			expectedError: "open values must be an array of numbers",
 		},
 		{
 			name: "invalid high type",
@@ -171,7 +171,7 @@ func TestOHLCInvalidTypes(t *testing.T) {
 				o.Low = []float64{32.0}
 				o.Close = []float64{33.5}
 			},
-			expectedError: "high values must be []float64",
+			expectedError: "high values must be an array of numbers",
 		},
 		{
 			name: "invalid low type",
@@ -181,7 +181,7 @@ func TestOHLCInvalidTypes(t *testing.T) {
 				o.Low = []string{"32.0"} // should be []float64
 				o.Close = []float64{33.5}
 			},
-			expectedError: "low values must be []float64",
+			expectedError: "low values must be an array of numbers",
 		},
 		{
 			name: "invalid close type",
@@ -191,7 +191,7 @@ func TestOHLCInvalidTypes(t *testing.T) {
 				o.Low = []float64{32.0}
 				o.Close = []string{"33.5"} // should be []float64
 			},
-			expectedError: "close values must be []float64",
+			expectedError: "close values must be an array of numbers",
 		},
 		{
 			name: "mismatched array lengths",
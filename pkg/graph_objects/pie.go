@@ -0,0 +1,346 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Pie directions
+const (
+	PieDirectionClockwise        = "clockwise"
+	PieDirectionCounterClockwise = "counterclockwise"
+)
+
+// Pie text info flags, combined with "+", e.g. "label+percent"
+const (
+	PieTextInfoLabel   = "label"
+	PieTextInfoText    = "text"
+	PieTextInfoValue   = "value"
+	PieTextInfoPercent = "percent"
+	PieTextInfoNone    = "none"
+)
+
+// Pie represents a pie trace. Setting Hole draws a donut chart.
+type Pie struct {
+	BaseTrace
+	// Data
+	Labels interface{} `json:"labels,omitempty"` // sector labels
+	Values interface{} `json:"values,omitempty"` // sector values, counts of Labels when omitted
+
+	// Layout Properties
+	Hole      float64     `json:"hole,omitempty"`      // fraction of the radius cut out, in [0, 1)
+	Pull      interface{} `json:"pull,omitempty"`      // number or array of fractions of the radius
+	Sort      *bool       `json:"sort,omitempty"`      // sort sectors by value
+	Direction string      `json:"direction,omitempty"` // "clockwise" or "counterclockwise"
+	Rotation  float64     `json:"rotation,omitempty"`  // start angle in degrees
+	Domain    *Domain     `json:"domain,omitempty"`
+	Marker    *PieMarker  `json:"marker,omitempty"`
+	Title     *PieTitle   `json:"title,omitempty"`
+
+	// Text and Hover Properties
+	Text            interface{} `json:"text,omitempty"`
+	TextInfo        string      `json:"textinfo,omitempty"`     // flags such as "label+percent", or "none"
+	TextPosition    interface{} `json:"textposition,omitempty"` // string or array
+	TextTemplate    string      `json:"texttemplate,omitempty"`
+	TextFont        *Font       `json:"textfont,omitempty"`
+	InsideTextFont  *Font       `json:"insidetextfont,omitempty"`
+	OutsideTextFont *Font       `json:"outsidetextfont,omitempty"`
+	HoverText       interface{} `json:"hovertext,omitempty"`
+	HoverTemplate   string      `json:"hovertemplate,omitempty"`
+	HoverLabel      *HoverLabel `json:"hoverlabel,omitempty"`
+	LegendGroup     string      `json:"legendgroup,omitempty"`
+}
+
+// PieMarker represents the sector colors and outlines of a pie trace
+type PieMarker struct {
+	Colors interface{} `json:"colors,omitempty"` // one color per sector
+	Line   *MarkerLine `json:"line,omitempty"`
+}
+
+// PieTitle represents the title drawn with a pie trace
+type PieTitle struct {
+	Text     string `json:"text,omitempty"`
+	Font     *Font  `json:"font,omitempty"`
+	Position string `json:"position,omitempty"` // e.g. "top center" or "middle center"
+}
+
+// NewPie creates a new pie trace
+func NewPie() *Pie {
+	return &Pie{
+		BaseTrace: BaseTrace{
+			Type: "pie",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (p *Pie) Validate() error {
+	if err := p.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if p.Labels == nil && p.Values == nil {
+		return &validation.ValidationError{
+			Field:   "Labels/Values",
+			Message: "at least one of Labels or Values must be provided",
+		}
+	}
+
+	sectors := -1
+	if p.Labels != nil {
+		length, ok := arrayLength(p.Labels)
+		if !ok {
+			return &validation.ValidationError{
+				Field:   "Labels",
+				Message: "labels must be an array",
+			}
+		}
+		sectors = length
+	}
+
+	// Validate values
+	if p.Values != nil {
+		values, ok := toFloat64Slice(p.Values)
+		if !ok {
+			return &validation.ValidationError{
+				Field:   "Values",
+				Message: "values must be an array of numbers",
+			}
+		}
+		if sectors >= 0 && len(values) != sectors {
+			return &validation.ValidationError{
+				Field:   "Labels/Values",
+				Message: fmt.Sprintf("labels and values must have the same length (%d != %d)", sectors, len(values)),
+			}
+		}
+		for i, v := range values {
+			if v < 0 {
+				return &validation.ValidationError{
+					Field:   "Values",
+					Message: fmt.Sprintf("value %d is negative: %g", i, v),
+				}
+			}
+		}
+		sectors = len(values)
+	}
+
+	// Validate hole and pull
+	if p.Hole < 0 || p.Hole >= 1 {
+		return &validation.ValidationError{
+			Field:   "Hole",
+			Message: "hole must be in [0, 1)",
+		}
+	}
+	if err := validatePull(p.Pull, sectors); err != nil {
+		return err
+	}
+
+	// Validate direction
+	if p.Direction != "" && p.Direction != PieDirectionClockwise && p.Direction != PieDirectionCounterClockwise {
+		return &validation.ValidationError{
+			Field:   "Direction",
+			Message: fmt.Sprintf("invalid direction: %s", p.Direction),
+		}
+	}
+	if p.Rotation < -360 || p.Rotation > 360 {
+		return &validation.ValidationError{
+			Field:   "Rotation",
+			Message: "rotation must be between -360 and 360 degrees",
+		}
+	}
+
+	// Validate text info and position
+	if err := validatePieTextInfo(p.TextInfo); err != nil {
+		return err
+	}
+	if err := validateTextPositions(p.TextPosition); err != nil {
+		return err
+	}
+
+	if p.Domain != nil {
+		if err := p.Domain.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePull checks that the pull is a fraction of the radius, either for
+// all sectors or one per sector
+func validatePull(pull interface{}, sectors int) error {
+	if pull == nil {
+		return nil
+	}
+	if v, ok := toFloat64(pull); ok {
+		if v < 0 || v > 1 {
+			return &validation.ValidationError{
+				Field:   "Pull",
+				Message: "pull must be between 0 and 1",
+			}
+		}
+		return nil
+	}
+
+	pulls, ok := toFloat64Slice(pull)
+	if !ok {
+		return &validation.ValidationError{
+			Field:   "Pull",
+			Message: "pull must be a number or an array of numbers",
+		}
+	}
+	if sectors >= 0 && len(pulls) > sectors {
+		return &validation.ValidationError{
+			Field:   "Pull",
+			Message: fmt.Sprintf("%d pulls given for %d sectors", len(pulls), sectors),
+		}
+	}
+	for _, v := range pulls {
+		if v < 0 || v > 1 {
+			return &validation.ValidationError{
+				Field:   "Pull",
+				Message: "pull must be between 0 and 1",
+			}
+		}
+	}
+	return nil
+}
+
+// validatePieTextInfo checks that text info is "none" or a "+" separated list
+// of flags
+func validatePieTextInfo(textInfo string) error {
+	if textInfo == "" || textInfo == PieTextInfoNone {
+		return nil
+	}
+	validFlags := map[string]bool{
+		PieTextInfoLabel:   true,
+		PieTextInfoText:    true,
+		PieTextInfoValue:   true,
+		PieTextInfoPercent: true,
+	}
+	return validateFlagList("TextInfo", "text info flag", textInfo, validFlags)
+}
+
+// validateTextPositions checks a text position given for all sectors or bars,
+// or one per sector or bar
+func validateTextPositions(textPosition interface{}) error {
+	validPositions := map[string]bool{
+		TextPositionInside:  true,
+		TextPositionOutside: true,
+		TextPositionAuto:    true,
+		TextPositionNone:    true,
+	}
+
+	var positions []interface{}
+	switch value := textPosition.(type) {
+	case nil:
+		return nil
+	case string:
+		positions = []interface{}{value}
+	case []string:
+		for _, position := range value {
+			positions = append(positions, position)
+		}
+	case []interface{}:
+		positions = value
+	default:
+		return &validation.ValidationError{
+			Field:   "TextPosition",
+			Message: "text position must be a string or an array of strings",
+		}
+	}
+
+	for _, position := range positions {
+		s, ok := position.(string)
+		if !ok || !validPositions[s] {
+			return &validation.ValidationError{
+				Field:   "TextPosition",
+				Message: fmt.Sprintf("invalid text position: %v", position),
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (p *Pie) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&p.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type
+	m["type"] = "pie"
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("labels", p.Labels)
+	addIfNotEmpty("values", p.Values)
+
+	// Layout Properties
+	if p.Hole != 0 {
+		m["hole"] = p.Hole
+	}
+	addIfNotEmpty("pull", p.Pull)
+	if p.Sort != nil {
+		m["sort"] = *p.Sort
+	}
+	if p.Direction != "" {
+		m["direction"] = p.Direction
+	}
+	if p.Rotation != 0 {
+		m["rotation"] = p.Rotation
+	}
+	if p.Domain != nil {
+		m["domain"] = p.Domain
+	}
+	if p.Marker != nil {
+		m["marker"] = p.Marker
+	}
+	if p.Title != nil {
+		m["title"] = p.Title
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", p.Text)
+	if p.TextInfo != "" {
+		m["textinfo"] = p.TextInfo
+	}
+	addIfNotEmpty("textposition", p.TextPosition)
+	if p.TextTemplate != "" {
+		m["texttemplate"] = p.TextTemplate
+	}
+	if p.TextFont != nil {
+		m["textfont"] = p.TextFont
+	}
+	if p.InsideTextFont != nil {
+		m["insidetextfont"] = p.InsideTextFont
+	}
+	if p.OutsideTextFont != nil {
+		m["outsidetextfont"] = p.OutsideTextFont
+	}
+	addIfNotEmpty("hovertext", p.HoverText)
+	if p.HoverTemplate != "" {
+		m["hovertemplate"] = p.HoverTemplate
+	}
+	if p.HoverLabel != nil {
+		m["hoverlabel"] = p.HoverLabel
+	}
+	if p.LegendGroup != "" {
+		m["legendgroup"] = p.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
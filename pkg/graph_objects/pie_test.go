@@ -0,0 +1,173 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPieValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Pie)
+		expectedError string
+	}{
+		{
+			name:          "valid pie",
+			setup:         func(p *Pie) {},
+			expectedError: "",
+		},
+		{
+			name: "missing labels and values",
+			setup: func(p *Pie) {
+				p.Labels = nil
+				p.Values = nil
+			},
+			expectedError: "at least one of Labels or Values must be provided",
+		},
+		{
+			name: "labels only",
+			setup: func(p *Pie) {
+				p.Values = nil
+			},
+			expectedError: "",
+		},
+		{
+			name: "mismatched lengths",
+			setup: func(p *Pie) {
+				p.Values = []float64{10, 20}
+			},
+			expectedError: "labels and values must have the same length (3 != 2)",
+		},
+		{
+			name: "negative value",
+			setup: func(p *Pie) {
+				p.Values = []float64{10, -5, 20}
+			},
+			expectedError: "value 1 is negative",
+		},
+		{
+			name: "values not numeric",
+			setup: func(p *Pie) {
+				p.Values = []string{"a", "b", "c"}
+			},
+			expectedError: "values must be an array of numbers",
+		},
+		{
+			name: "donut",
+			setup: func(p *Pie) {
+				p.Hole = 0.4
+			},
+			expectedError: "",
+		},
+		{
+			name: "hole of 1",
+			setup: func(p *Pie) {
+				p.Hole = 1
+			},
+			expectedError: "hole must be in [0, 1)",
+		},
+		{
+			name: "pull per sector",
+			setup: func(p *Pie) {
+				p.Pull = []float64{0, 0.2, 0}
+			},
+			expectedError: "",
+		},
+		{
+			name: "pull out of range",
+			setup: func(p *Pie) {
+				p.Pull = 1.5
+			},
+			expectedError: "pull must be between 0 and 1",
+		},
+		{
+			name: "invalid direction",
+			setup: func(p *Pie) {
+				p.Direction = "left"
+			},
+			expectedError: "invalid direction: left",
+		},
+		{
+			name: "text info flags",
+			setup: func(p *Pie) {
+				p.TextInfo = "label+percent"
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid text info flag",
+			setup: func(p *Pie) {
+				p.TextInfo = "label+ratio"
+			},
+			expectedError: "invalid text info flag: ratio",
+		},
+		{
+			name: "text position per sector",
+			setup: func(p *Pie) {
+				p.TextPosition = []string{TextPositionInside, TextPositionOutside, TextPositionAuto}
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid text position",
+			setup: func(p *Pie) {
+				p.TextPosition = "top"
+			},
+			expectedError: "invalid text position: top",
+		},
+		{
+			name: "invalid domain",
+			setup: func(p *Pie) {
+				p.Domain = &Domain{X: []float64{0.5, 1.2}}
+			},
+			expectedError: "domain must be a [start, end] range within [0, 1]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPie()
+			p.Labels = []string{"compute", "storage", "network"}
+			p.Values = []float64{120, 45, 30}
+			tt.setup(p)
+
+			err := p.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestPieMarshalJSON(t *testing.T) {
+	p := NewPie()
+	p.Labels = []string{"compute", "storage"}
+	p.Values = []float64{120, 45}
+	p.Hole = 0.5
+	p.Sort = Bool(false)
+	p.Direction = PieDirectionClockwise
+	p.TextInfo = "label+percent"
+	p.InsideTextFont = &Font{Color: "white"}
+	p.Domain = &Domain{X: []float64{0, 0.5}}
+
+	data, err := json.Marshal(p)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"pie"`)
+	assert.Contains(t, jsonStr, `"labels":["compute","storage"]`)
+	assert.Contains(t, jsonStr, `"values":[120,45]`)
+	assert.Contains(t, jsonStr, `"hole":0.5`)
+	assert.Contains(t, jsonStr, `"sort":false`)
+	assert.Contains(t, jsonStr, `"direction":"clockwise"`)
+	assert.Contains(t, jsonStr, `"textinfo":"label+percent"`)
+	assert.Contains(t, jsonStr, `"insidetextfont":{"color":"white"}`)
+	assert.Contains(t, jsonStr, `"domain":{"x":[0,0.5]}`)
+	assert.NotContains(t, jsonStr, `"pull"`)
+	assert.NotContains(t, jsonStr, `"rotation"`)
+}
@@ -0,0 +1,242 @@
+package graph_objects
+
+import (
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Theta units of polar traces and angular axes
+const (
+	ThetaUnitDegrees = "degrees"
+	ThetaUnitRadians = "radians"
+)
+
+// Angular axis directions
+const (
+	AngularDirectionClockwise        = "clockwise"
+	AngularDirectionCounterClockwise = "counterclockwise"
+)
+
+// Polar grid shapes
+const (
+	GridShapeCircular = "circular"
+	GridShapeLinear   = "linear"
+)
+
+// Polar represents the polar subplot that Scatterpolar and Barpolar traces are
+// drawn in. Traces refer to additional subplots such as "polar2", which can be
+// set through Layout.Extra.
+type Polar struct {
+	RadialAxis  *RadialAxis  `json:"radialaxis,omitempty"`
+	AngularAxis *AngularAxis `json:"angularaxis,omitempty"`
+	Hole        float64      `json:"hole,omitempty"`      // fraction of the radius cut out, in [0, 1)
+	Sector      []float64    `json:"sector,omitempty"`    // [start, end] angles in degrees
+	GridShape   string       `json:"gridshape,omitempty"` // "circular" or "linear"
+	BarMode     string       `json:"barmode,omitempty"`   // "stack" or "overlay"
+	BarGap      *float64     `json:"bargap,omitempty"`
+	Domain      *Domain      `json:"domain,omitempty"`
+	BgColor     string       `json:"bgcolor,omitempty"`
+}
+
+// RadialAxis represents the radial axis of a polar subplot
+type RadialAxis struct {
+	Title      *Title        `json:"title,omitempty"`
+	Type       string        `json:"type,omitempty"`
+	Range      []interface{} `json:"range,omitempty"`
+	AutoRange  interface{}   `json:"autorange,omitempty"` // bool or "reversed"
+	Angle      float64       `json:"angle,omitempty"`     // angle the axis is drawn at, in degrees
+	Side       string        `json:"side,omitempty"`      // side of the tick labels, "clockwise" or "counterclockwise"
+	Visible    *bool         `json:"visible,omitempty"`
+	ShowGrid   *bool         `json:"showgrid,omitempty"`
+	GridColor  string        `json:"gridcolor,omitempty"`
+	GridWidth  float64       `json:"gridwidth,omitempty"`
+	ShowLine   *bool         `json:"showline,omitempty"`
+	LineColor  string        `json:"linecolor,omitempty"`
+	LineWidth  float64       `json:"linewidth,omitempty"`
+	TickFormat string        `json:"tickformat,omitempty"`
+	TickSuffix string        `json:"ticksuffix,omitempty"`
+	TickFont   *Font         `json:"tickfont,omitempty"`
+	NTicks     int           `json:"nticks,omitempty"`
+}
+
+// AngularAxis represents the angular axis of a polar subplot
+type AngularAxis struct {
+	Type      string      `json:"type,omitempty"`      // "linear" or "category"
+	Rotation  float64     `json:"rotation,omitempty"`  // angle of the first tick, in degrees
+	Direction string      `json:"direction,omitempty"` // "clockwise" or "counterclockwise"
+	ThetaUnit string      `json:"thetaunit,omitempty"` // "degrees" or "radians"
+	Period    float64     `json:"period,omitempty"`    // number of categories in a full turn
+	Visible   *bool       `json:"visible,omitempty"`
+	ShowGrid  *bool       `json:"showgrid,omitempty"`
+	GridColor string      `json:"gridcolor,omitempty"`
+	GridWidth float64     `json:"gridwidth,omitempty"`
+	ShowLine  *bool       `json:"showline,omitempty"`
+	LineColor string      `json:"linecolor,omitempty"`
+	LineWidth float64     `json:"linewidth,omitempty"`
+	TickFont  *Font       `json:"tickfont,omitempty"`
+	TickVals  interface{} `json:"tickvals,omitempty"`
+	TickText  interface{} `json:"ticktext,omitempty"`
+	DTick     interface{} `json:"dtick,omitempty"`
+	NTicks    int         `json:"nticks,omitempty"`
+}
+
+// validate checks the axes, hole, sector and bar settings of a polar subplot
+func (p *Polar) validate(field string) error {
+	if p.RadialAxis != nil {
+		if err := p.RadialAxis.validate(field + ".RadialAxis"); err != nil {
+			return err
+		}
+	}
+	if p.AngularAxis != nil {
+		if err := p.AngularAxis.validate(field + ".AngularAxis"); err != nil {
+			return err
+		}
+	}
+
+	if p.Hole < 0 || p.Hole >= 1 {
+		return &validation.ValidationError{
+			Field:   field + ".Hole",
+			Message: "hole must be in [0, 1)",
+		}
+	}
+	if p.Sector != nil && (len(p.Sector) != 2 || p.Sector[0] == p.Sector[1]) {
+		return &validation.ValidationError{
+			Field:   field + ".Sector",
+			Message: "sector must be a [start, end] range of angles",
+		}
+	}
+	if p.GridShape != "" && p.GridShape != GridShapeCircular && p.GridShape != GridShapeLinear {
+		return &validation.ValidationError{
+			Field:   field + ".GridShape",
+			Message: fmt.Sprintf("invalid grid shape: %s", p.GridShape),
+		}
+	}
+
+	if p.BarMode != "" && p.BarMode != string(BarModeStack) && p.BarMode != string(BarModeOverlay) {
+		return &validation.ValidationError{
+			Field:   field + ".BarMode",
+			Message: fmt.Sprintf("invalid polar bar mode: %s", p.BarMode),
+		}
+	}
+	if p.BarGap != nil && (*p.BarGap < 0 || *p.BarGap > 1) {
+		return &validation.ValidationError{
+			Field:   field + ".BarGap",
+			Message: "gap must be between 0 and 1",
+		}
+	}
+
+	if p.Domain != nil {
+		return p.Domain.validate()
+	}
+	return nil
+}
+
+func (a *RadialAxis) validate(field string) error {
+	if a.Type != "" {
+		validTypes := map[string]bool{
+			string(AxisTypeAuto):     true,
+			string(AxisTypeLinear):   true,
+			string(AxisTypeLog):      true,
+			string(AxisTypeDate):     true,
+			string(AxisTypeCategory): true,
+		}
+		if !validTypes[a.Type] {
+			return &validation.ValidationError{
+				Field:   field + ".Type",
+				Message: fmt.Sprintf("invalid axis type: %s", a.Type),
+			}
+		}
+	}
+
+	if a.Range != nil && len(a.Range) != 2 {
+		return &validation.ValidationError{
+			Field:   field + ".Range",
+			Message: "range must have exactly two values",
+		}
+	}
+
+	if a.Side != "" && a.Side != AngularDirectionClockwise && a.Side != AngularDirectionCounterClockwise {
+		return &validation.ValidationError{
+			Field:   field + ".Side",
+			Message: fmt.Sprintf("invalid radial axis side: %s", a.Side),
+		}
+	}
+
+	if err := validateAxisLines(field, a.GridWidth, a.LineWidth, a.NTicks); err != nil {
+		return err
+	}
+
+	if a.Title != nil {
+		if err := validateLayoutFont(a.Title.Font, field+".Title.Font"); err != nil {
+			return err
+		}
+	}
+
+	return validateLayoutFont(a.TickFont, field+".TickFont")
+}
+
+func (a *AngularAxis) validate(field string) error {
+	if a.Type != "" && a.Type != string(AxisTypeAuto) && a.Type != string(AxisTypeLinear) && a.Type != string(AxisTypeCategory) {
+		return &validation.ValidationError{
+			Field:   field + ".Type",
+			Message: fmt.Sprintf("invalid axis type: %s", a.Type),
+		}
+	}
+
+	if a.Direction != "" && a.Direction != AngularDirectionClockwise && a.Direction != AngularDirectionCounterClockwise {
+		return &validation.ValidationError{
+			Field:   field + ".Direction",
+			Message: fmt.Sprintf("invalid direction: %s", a.Direction),
+		}
+	}
+
+	if err := validateThetaUnit(field+".ThetaUnit", a.ThetaUnit); err != nil {
+		return err
+	}
+
+	if a.Period < 0 {
+		return &validation.ValidationError{
+			Field:   field + ".Period",
+			Message: "period must be non-negative",
+		}
+	}
+
+	if err := validateAxisLines(field, a.GridWidth, a.LineWidth, a.NTicks); err != nil {
+		return err
+	}
+
+	return validateLayoutFont(a.TickFont, field+".TickFont")
+}
+
+// validateThetaUnit validates the unit of polar angles, "degrees" or
+// "radians"
+func validateThetaUnit(field, unit string) error {
+	if unit != "" && unit != ThetaUnitDegrees && unit != ThetaUnitRadians {
+		return &validation.ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("invalid theta unit: %s", unit),
+		}
+	}
+	return nil
+}
+
+// validatePolarData checks that r is provided and that r and theta given as
+// arrays have the same length
+func validatePolarData(r, theta interface{}) error {
+	if r == nil {
+		return &validation.ValidationError{
+			Field:   "R",
+			Message: "R must be provided",
+		}
+	}
+	rLength, rOK := arrayLength(r)
+	thetaLength, thetaOK := arrayLength(theta)
+	if rOK && thetaOK && rLength != thetaLength {
+		return &validation.ValidationError{
+			Field:   "R/Theta",
+			Message: fmt.Sprintf("r and theta must have the same length (%d != %d)", rLength, thetaLength),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolarValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		polar         *Polar
+		expectedError string
+	}{
+		{
+			name: "valid polar",
+			polar: &Polar{
+				RadialAxis:  &RadialAxis{Range: []interface{}{0, 10}, TickSuffix: " km/h"},
+				AngularAxis: &AngularAxis{Direction: AngularDirectionClockwise, Rotation: 90},
+				Hole:        0.1,
+				BarMode:     string(BarModeStack),
+			},
+			expectedError: "",
+		},
+		{
+			name:          "hole out of range",
+			polar:         &Polar{Hole: 1},
+			expectedError: "hole must be in [0, 1)",
+		},
+		{
+			name:          "half circle sector",
+			polar:         &Polar{Sector: []float64{0, 180}},
+			expectedError: "",
+		},
+		{
+			name:          "invalid sector",
+			polar:         &Polar{Sector: []float64{90}},
+			expectedError: "sector must be a [start, end] range of angles",
+		},
+		{
+			name:          "invalid bar mode",
+			polar:         &Polar{BarMode: string(BarModeGroup)},
+			expectedError: "invalid polar bar mode: group",
+		},
+		{
+			name:          "invalid grid shape",
+			polar:         &Polar{GridShape: "hexagonal"},
+			expectedError: "invalid grid shape: hexagonal",
+		},
+		{
+			name:          "invalid radial axis side",
+			polar:         &Polar{RadialAxis: &RadialAxis{Side: "left"}},
+			expectedError: "invalid radial axis side: left",
+		},
+		{
+			name:          "invalid angular direction",
+			polar:         &Polar{AngularAxis: &AngularAxis{Direction: "anticlockwise"}},
+			expectedError: "invalid direction: anticlockwise",
+		},
+		{
+			name:          "invalid angular axis type",
+			polar:         &Polar{AngularAxis: &AngularAxis{Type: string(AxisTypeLog)}},
+			expectedError: "invalid axis type: log",
+		},
+		{
+			name:          "invalid angular theta unit",
+			polar:         &Polar{AngularAxis: &AngularAxis{ThetaUnit: "turns"}},
+			expectedError: "invalid theta unit: turns",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Layout{Polar: tt.polar}).Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestPolarMarshalJSON(t *testing.T) {
+	layout := &Layout{
+		Polar: &Polar{
+			AngularAxis: &AngularAxis{Direction: AngularDirectionClockwise, Rotation: 90},
+			Hole:        0.2,
+		},
+	}
+
+	data, err := json.Marshal(layout)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"polar":{"angularaxis":{"rotation":90,"direction":"clockwise"},"hole":0.2}`)
+
+	// Polar subplots decode into the typed field rather than Extra
+	var decoded Layout
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, 0.2, decoded.Polar.Hole)
+	assert.Empty(t, decoded.Extra)
+}
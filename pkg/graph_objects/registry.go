@@ -0,0 +1,176 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// TraceFactory creates an empty trace of a registered type
+type TraceFactory func() Trace
+
+var (
+	registryMu    sync.RWMutex
+	traceRegistry = map[string]TraceFactory{
+		"bar":                func() Trace { return NewBar() },
+		"barpolar":           func() Trace { return NewBarpolar() },
+		"box":                func() Trace { return NewBox() },
+		"candlestick":        func() Trace { return NewCandlestick() },
+		"choropleth":         func() Trace { return NewChoropleth() },
+		"contour":            func() Trace { return NewContour() },
+		"funnel":             func() Trace { return NewFunnel() },
+		"heatmap":            func() Trace { return NewHeatmap() },
+		"histogram":          func() Trace { return NewHistogram() },
+		"histogram2d":        func() Trace { return NewHistogram2d() },
+		"histogram2dcontour": func() Trace { return NewHistogram2dContour() },
+		"icicle":             func() Trace { return NewIcicle() },
+		"indicator":          func() Trace { return NewIndicator() },
+		"mesh3d":             func() Trace { return NewMesh3d() },
+		"ohlc":               func() Trace { return NewOHLC() },
+		"pie":                func() Trace { return NewPie() },
+		"sankey":             func() Trace { return NewSankey() },
+		"scatter":            func() Trace { return NewScatter() },
+		"scatter3d":          func() Trace { return NewScatter3d() },
+		"scattergeo":         func() Trace { return NewScattergeo() },
+		"scattergl":          func() Trace { return NewScattergl() },
+		"scatterpolar":       func() Trace { return NewScatterpolar() },
+		"sunburst":           func() Trace { return NewSunburst() },
+		"surface":            func() Trace { return NewSurface() },
+		"table":              func() Trace { return NewTable() },
+		"treemap":            func() Trace { return NewTreemap() },
+		"violin":             func() Trace { return NewViolin() },
+		"waterfall":          func() Trace { return NewWaterfall() },
+	}
+)
+
+// RegisterTraceType registers a factory for the given plotly trace type so
+// that DecodeTrace can decode it into a concrete Go type
+func RegisterTraceType(traceType string, factory TraceFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	traceRegistry[traceType] = factory
+}
+
+// RegisteredTraceTypes returns the trace types known to DecodeTrace
+func RegisteredTraceTypes() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	types := make([]string, 0, len(traceRegistry))
+	for traceType := range traceRegistry {
+		types = append(types, traceType)
+	}
+	return types
+}
+
+// DecodeTrace decodes a JSON trace into the concrete type registered for its
+// "type" attribute. Traces of unknown type are decoded as a GenericTrace.
+// Attributes without a typed field are kept in the Extra properties of the
+// trace.
+func DecodeTrace(data []byte) (Trace, error) {
+	var header struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	// plotly.js treats traces without a type as scatter traces
+	traceType := header.Type
+	if traceType == "" {
+		traceType = "scatter"
+	}
+
+	registryMu.RLock()
+	factory, ok := traceRegistry[traceType]
+	registryMu.RUnlock()
+
+	if !ok {
+		generic := GenericTrace{}
+		if err := json.Unmarshal(data, &generic); err != nil {
+			return nil, err
+		}
+		return generic, nil
+	}
+
+	trace := factory()
+	if err := json.Unmarshal(data, trace); err != nil {
+		return nil, fmt.Errorf("error decoding %s trace: %v", traceType, err)
+	}
+	if err := decodeExtra(trace, data); err != nil {
+		return nil, fmt.Errorf("error decoding %s trace: %v", traceType, err)
+	}
+	return trace, nil
+}
+
+// decodeExtra keeps the attributes of a JSON trace without a typed field in
+// the Extra properties of the trace, so that they survive a round trip
+func decodeExtra(trace Trace, data []byte) error {
+	v := reflect.ValueOf(trace)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	extra := v.Elem().FieldByName("Extra")
+	if !extra.IsValid() || extra.Type() != reflect.TypeOf(map[string]interface{}{}) {
+		return nil
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return err
+	}
+	known := structAttributes(v.Elem().Type())
+	for k, raw := range m {
+		if known[k] {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		if extra.IsNil() {
+			extra.Set(reflect.MakeMap(extra.Type()))
+		}
+		extra.SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(value))
+	}
+	return nil
+}
+
+// GenericTrace is a trace of a type without a registered Go type. Its
+// attributes are kept as-is.
+type GenericTrace map[string]interface{}
+
+// TraceType returns the value of the "type" attribute
+func (g GenericTrace) TraceType() string {
+	traceType, _ := g["type"].(string)
+	return traceType
+}
+
+// GetName returns the value of the "name" attribute
+func (g GenericTrace) GetName() string {
+	name, _ := g["name"].(string)
+	return name
+}
+
+// SetName sets the "name" attribute
+func (g GenericTrace) SetName(name string) {
+	g["name"] = name
+}
+
+// Validate implements the Validator interface
+func (g GenericTrace) Validate() error {
+	if g.TraceType() == "" {
+		return &validation.ValidationError{
+			Field:   "Type",
+			Message: "trace type cannot be empty",
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (g GenericTrace) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]interface{}(g))
+}
@@ -0,0 +1,248 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeTrace(t *testing.T) {
+	tests := []struct {
+		name     string
+		trace    Trace
+		wantType interface{}
+	}{
+		{
+			name:     "scatter",
+			trace:    &Scatter{BaseTrace: BaseTrace{Type: "scatter"}, X: []float64{1, 2}, Y: []float64{3, 4}},
+			wantType: &Scatter{},
+		},
+		{
+			name:     "bar",
+			trace:    &Bar{BaseTrace: BaseTrace{Type: "bar"}, X: []string{"a", "b"}, Y: []float64{1, 2}},
+			wantType: &Bar{},
+		},
+		{
+			name:     "box",
+			trace:    &Box{BaseTrace: BaseTrace{Type: "box"}, Y: []float64{1, 2, 3}},
+			wantType: &Box{},
+		},
+		{
+			name:     "histogram",
+			trace:    &Histogram{BaseTrace: BaseTrace{Type: "histogram"}, X: []float64{1, 2, 2}},
+			wantType: &Histogram{},
+		},
+		{
+			name: "ohlc",
+			trace: &OHLC{
+				BaseTrace: BaseTrace{Type: "ohlc"},
+				X:         []string{"2024-01-01"},
+				Open:      []float64{2},
+				High:      []float64{3},
+				Low:       []float64{1},
+				Close:     []float64{2.5},
+			},
+			wantType: &OHLC{},
+		},
+		{
+			name: "candlestick",
+			trace: &Candlestick{
+				BaseTrace: BaseTrace{Type: "candlestick"},
+				X:         []string{"2024-01-01"},
+				Open:      []float64{2},
+				High:      []float64{3},
+				Low:       []float64{1},
+				Close:     []float64{2.5},
+			},
+			wantType: &Candlestick{},
+		},
+		{
+			name:     "heatmap",
+			trace:    &Heatmap{BaseTrace: BaseTrace{Type: "heatmap"}, Z: [][]float64{{1, 2}, {3, 4}}},
+			wantType: &Heatmap{},
+		},
+		{
+			name:     "contour",
+			trace:    &Contour{BaseTrace: BaseTrace{Type: "contour"}, Z: [][]float64{{1, 2}, {3, 4}}},
+			wantType: &Contour{},
+		},
+		{
+			name:     "histogram2d",
+			trace:    &Histogram2d{BaseTrace: BaseTrace{Type: "histogram2d"}, X: []float64{1, 2}, Y: []float64{3, 4}},
+			wantType: &Histogram2d{},
+		},
+		{
+			name:     "histogram2dcontour",
+			trace:    &Histogram2dContour{BaseTrace: BaseTrace{Type: "histogram2dcontour"}, X: []float64{1, 2}, Y: []float64{3, 4}},
+			wantType: &Histogram2dContour{},
+		},
+		{
+			name:     "pie",
+			trace:    &Pie{BaseTrace: BaseTrace{Type: "pie"}, Labels: []string{"a", "b"}, Values: []float64{1, 2}},
+			wantType: &Pie{},
+		},
+		{
+			name:     "violin",
+			trace:    &Violin{BaseTrace: BaseTrace{Type: "violin"}, Y: []float64{1, 2, 3}},
+			wantType: &Violin{},
+		},
+		{
+			name:     "waterfall",
+			trace:    &Waterfall{BaseTrace: BaseTrace{Type: "waterfall"}, Y: []float64{10, -3}, Measure: []string{MeasureAbsolute, MeasureRelative}},
+			wantType: &Waterfall{},
+		},
+		{
+			name:     "funnel",
+			trace:    &Funnel{BaseTrace: BaseTrace{Type: "funnel"}, X: []float64{100, 40}, Y: []string{"visit", "signup"}},
+			wantType: &Funnel{},
+		},
+		{
+			name:     "sunburst",
+			trace:    &Sunburst{BaseTrace: BaseTrace{Type: "sunburst"}, Labels: []string{"a", "b"}, Parents: []string{"", "a"}},
+			wantType: &Sunburst{},
+		},
+		{
+			name:     "treemap",
+			trace:    &Treemap{BaseTrace: BaseTrace{Type: "treemap"}, Labels: []string{"a", "b"}, Parents: []string{"", "a"}},
+			wantType: &Treemap{},
+		},
+		{
+			name:     "icicle",
+			trace:    &Icicle{BaseTrace: BaseTrace{Type: "icicle"}, Labels: []string{"a", "b"}, Parents: []string{"", "a"}},
+			wantType: &Icicle{},
+		},
+		{
+			name:     "sankey",
+			trace:    &Sankey{BaseTrace: BaseTrace{Type: "sankey"}, Node: &SankeyNode{Label: []string{"a", "b"}}, Link: &SankeyLink{Source: []int{0}, Target: []int{1}, Value: []float64{1}}},
+			wantType: &Sankey{},
+		},
+		{
+			name:     "scatter3d",
+			trace:    &Scatter3d{BaseTrace: BaseTrace{Type: "scatter3d"}, X: []float64{1, 2}, Y: []float64{3, 4}, Z: []float64{5, 6}},
+			wantType: &Scatter3d{},
+		},
+		{
+			name:     "surface",
+			trace:    &Surface{BaseTrace: BaseTrace{Type: "surface"}, Z: [][]float64{{1, 2}, {3, 4}}},
+			wantType: &Surface{},
+		},
+		{
+			name:     "mesh3d",
+			trace:    &Mesh3d{BaseTrace: BaseTrace{Type: "mesh3d"}, X: []float64{0, 1, 0}, Y: []float64{0, 0, 1}, Z: []float64{0, 0, 0}, I: []int{0}, J: []int{1}, K: []int{2}},
+			wantType: &Mesh3d{},
+		},
+		{
+			name:     "scatterpolar",
+			trace:    &Scatterpolar{BaseTrace: BaseTrace{Type: "scatterpolar"}, R: []float64{1, 2}, Theta: []float64{0, 90}},
+			wantType: &Scatterpolar{},
+		},
+		{
+			name:     "barpolar",
+			trace:    &Barpolar{BaseTrace: BaseTrace{Type: "barpolar"}, R: []float64{1, 2}, Theta: []string{"N", "E"}, Width: []float64{45, 45}},
+			wantType: &Barpolar{},
+		},
+		{
+			name:     "scattergl",
+			trace:    &Scattergl{Scatter: Scatter{BaseTrace: BaseTrace{Type: "scattergl"}, X: []float64{1, 2}, Y: []float64{3, 4}}},
+			wantType: &Scattergl{},
+		},
+		{
+			name:     "scattergeo",
+			trace:    &Scattergeo{BaseTrace: BaseTrace{Type: "scattergeo"}, Lon: []float64{2.35}, Lat: []float64{48.86}},
+			wantType: &Scattergeo{},
+		},
+		{
+			name: "choropleth",
+			trace: &Choropleth{
+				BaseTrace: BaseTrace{Type: "choropleth"},
+				Locations: []string{"a"},
+				Z:         []float64{1},
+				GeoJSON:   &GeoJSON{Type: "FeatureCollection", Features: []*GeoJSONFeature{{Type: "Feature", ID: "a", Geometry: json.RawMessage("null")}}},
+			},
+			wantType: &Choropleth{},
+		},
+		{
+			name: "table",
+			trace: &Table{
+				BaseTrace: BaseTrace{Type: "table"},
+				Header:    &TableHeader{Values: []interface{}{"a", "b"}},
+				Cells:     &TableCells{Values: [][]interface{}{{"x"}, {1}}},
+			},
+			wantType: &Table{},
+		},
+		{
+			name:     "indicator",
+			trace:    &Indicator{BaseTrace: BaseTrace{Type: "indicator"}, Mode: "number+delta", Value: 5, Delta: &IndicatorDelta{Reference: Float64(4)}},
+			wantType: &Indicator{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.trace)
+			assert.NoError(t, err)
+
+			decoded, err := DecodeTrace(data)
+			assert.NoError(t, err)
+			assert.IsType(t, tt.wantType, decoded)
+			assert.Equal(t, tt.trace.TraceType(), decoded.TraceType())
+			assert.NoError(t, decoded.Validate())
+			assert.Empty(t, reflect.ValueOf(decoded).Elem().FieldByName("Extra").Interface(),
+				"attributes with a typed field should not be kept in Extra")
+		})
+	}
+}
+
+func TestDecodeTraceExtra(t *testing.T) {
+	data := []byte(`{"type":"bar","x":[1],"y":[2],"xaxis":"x2","hovertemplate":"%{y}"}`)
+	decoded, err := DecodeTrace(data)
+	assert.NoError(t, err)
+
+	bar, ok := decoded.(*Bar)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"xaxis": "x2", "hovertemplate": "%{y}"}, bar.Extra)
+
+	encoded, err := json.Marshal(bar)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), string(encoded))
+}
+
+func TestDecodeTraceUnknownType(t *testing.T) {
+	decoded, err := DecodeTrace([]byte(`{"type":"custom","name":"c","values":[1,2]}`))
+	assert.NoError(t, err)
+
+	generic, ok := decoded.(GenericTrace)
+	assert.True(t, ok)
+	assert.Equal(t, "custom", generic.TraceType())
+	assert.Equal(t, "c", generic.GetName())
+	assert.NoError(t, generic.Validate())
+
+	data, err := json.Marshal(generic)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type":"custom","name":"c","values":[1,2]}`, string(data))
+}
+
+func TestDecodeTraceDefaultsToScatter(t *testing.T) {
+	decoded, err := DecodeTrace([]byte(`{"x":[1,2],"y":[3,4]}`))
+	assert.NoError(t, err)
+	assert.IsType(t, &Scatter{}, decoded)
+}
+
+func TestRegisterTraceType(t *testing.T) {
+	RegisterTraceType("custombar", func() Trace {
+		return &Bar{BaseTrace: BaseTrace{Type: "custombar"}}
+	})
+	defer func() {
+		registryMu.Lock()
+		delete(traceRegistry, "custombar")
+		registryMu.Unlock()
+	}()
+
+	assert.Contains(t, RegisteredTraceTypes(), "custombar")
+
+	decoded, err := DecodeTrace([]byte(`{"type":"custombar","x":["a"],"y":[1]}`))
+	assert.NoError(t, err)
+	assert.IsType(t, &Bar{}, decoded)
+}
@@ -0,0 +1,282 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Sankey node arrangements
+const (
+	ArrangementSnap          = "snap"
+	ArrangementPerpendicular = "perpendicular"
+	ArrangementFreeform      = "freeform"
+	ArrangementFixed         = "fixed"
+)
+
+// Sankey represents a sankey trace, which draws flows between nodes as links
+// whose width is proportional to their value
+type Sankey struct {
+	BaseTrace
+	// Data
+	Node *SankeyNode `json:"node,omitempty"`
+	Link *SankeyLink `json:"link,omitempty"`
+
+	// Layout Properties
+	Orientation string  `json:"orientation,omitempty"` // "h" or "v"
+	Arrangement string  `json:"arrangement,omitempty"` // "snap", "perpendicular", "freeform" or "fixed"
+	Domain      *Domain `json:"domain,omitempty"`
+
+	// Text and Hover Properties
+	ValueFormat string      `json:"valueformat,omitempty"` // d3 format of the values, e.g. ".2s"
+	ValueSuffix string      `json:"valuesuffix,omitempty"`
+	TextFont    *Font       `json:"textfont,omitempty"`
+	HoverLabel  *HoverLabel `json:"hoverlabel,omitempty"`
+}
+
+// SankeyNode represents the nodes of a sankey trace. Nodes are referenced by
+// their index in the links.
+type SankeyNode struct {
+	Label         []string    `json:"label,omitempty"`
+	Color         interface{} `json:"color,omitempty"`     // string or array, one per node
+	Pad           float64     `json:"pad,omitempty"`       // padding between nodes in pixels
+	Thickness     float64     `json:"thickness,omitempty"` // node thickness in pixels
+	X             []float64   `json:"x,omitempty"`         // node positions in [0, 1]
+	Y             []float64   `json:"y,omitempty"`
+	Line          *MarkerLine `json:"line,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+}
+
+// SankeyLink represents the links of a sankey trace, flowing from the node at
+// index Source[i] to the node at index Target[i]
+type SankeyLink struct {
+	Source        []int       `json:"source,omitempty"`
+	Target        []int       `json:"target,omitempty"`
+	Value         []float64   `json:"value,omitempty"`
+	Color         interface{} `json:"color,omitempty"` // string or array, one per link
+	Label         []string    `json:"label,omitempty"`
+	Line          *MarkerLine `json:"line,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+}
+
+// NewSankey creates a new sankey trace
+func NewSankey() *Sankey {
+	return &Sankey{
+		BaseTrace: BaseTrace{
+			Type: "sankey",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (s *Sankey) Validate() error {
+	if err := s.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if s.Link == nil {
+		return &validation.ValidationError{
+			Field:   "Link",
+			Message: "link must be provided",
+		}
+	}
+
+	// Nodes are counted by their labels; without labels plotly creates
+	// nodes for all indices used by the links
+	nodes := -1
+	if s.Node != nil {
+		if err := s.Node.validate(); err != nil {
+			return err
+		}
+		if s.Node.Label != nil {
+			nodes = len(s.Node.Label)
+		}
+	}
+	if err := s.Link.validate(nodes); err != nil {
+		return err
+	}
+
+	if s.Orientation != "" && s.Orientation != "h" && s.Orientation != "v" {
+		return &validation.ValidationError{
+			Field:   "Orientation",
+			Message: fmt.Sprintf("invalid orientation: %s", s.Orientation),
+		}
+	}
+	validArrangements := map[string]bool{
+		ArrangementSnap:          true,
+		ArrangementPerpendicular: true,
+		ArrangementFreeform:      true,
+		ArrangementFixed:         true,
+	}
+	if s.Arrangement != "" && !validArrangements[s.Arrangement] {
+		return &validation.ValidationError{
+			Field:   "Arrangement",
+			Message: fmt.Sprintf("invalid arrangement: %s", s.Arrangement),
+		}
+	}
+
+	if s.Domain != nil {
+		if err := s.Domain.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *SankeyNode) validate() error {
+	if n.Pad < 0 {
+		return &validation.ValidationError{
+			Field:   "Node.Pad",
+			Message: "node pad must be non-negative",
+		}
+	}
+	if n.Thickness < 0 {
+		return &validation.ValidationError{
+			Field:   "Node.Thickness",
+			Message: "node thickness must be non-negative",
+		}
+	}
+
+	if len(n.X) != len(n.Y) {
+		return &validation.ValidationError{
+			Field:   "Node.X/Node.Y",
+			Message: fmt.Sprintf("node x and y must have the same length (%d != %d)", len(n.X), len(n.Y)),
+		}
+	}
+	for i := range n.X {
+		if n.X[i] < 0 || n.X[i] > 1 || n.Y[i] < 0 || n.Y[i] > 1 {
+			return &validation.ValidationError{
+				Field:   "Node.X/Node.Y",
+				Message: fmt.Sprintf("position of node %d must be within [0, 1]", i),
+			}
+		}
+	}
+
+	if n.Label != nil {
+		if length, ok := arrayLength(n.Color); ok && length != len(n.Label) {
+			return &validation.ValidationError{
+				Field:   "Node.Color",
+				Message: fmt.Sprintf("%d colors given for %d nodes", length, len(n.Label)),
+			}
+		}
+	}
+	return nil
+}
+
+// validate checks that the links have matching lengths, reference existing
+// nodes and carry non-negative values. A negative node count skips the range
+// check against the nodes.
+func (l *SankeyLink) validate(nodes int) error {
+	if len(l.Source) != len(l.Target) {
+		return &validation.ValidationError{
+			Field:   "Link.Source/Link.Target",
+			Message: fmt.Sprintf("link source and target must have the same length (%d != %d)", len(l.Source), len(l.Target)),
+		}
+	}
+	links := len(l.Source)
+
+	if l.Value != nil && len(l.Value) != links {
+		return &validation.ValidationError{
+			Field:   "Link.Value",
+			Message: fmt.Sprintf("%d values given for %d links", len(l.Value), links),
+		}
+	}
+	if l.Label != nil && len(l.Label) != links {
+		return &validation.ValidationError{
+			Field:   "Link.Label",
+			Message: fmt.Sprintf("%d labels given for %d links", len(l.Label), links),
+		}
+	}
+	if length, ok := arrayLength(l.Color); ok && length != links {
+		return &validation.ValidationError{
+			Field:   "Link.Color",
+			Message: fmt.Sprintf("%d colors given for %d links", length, links),
+		}
+	}
+
+	indices := []struct {
+		field  string
+		values []int
+	}{
+		{"Link.Source", l.Source},
+		{"Link.Target", l.Target},
+	}
+	for _, idx := range indices {
+		for i, node := range idx.values {
+			if node < 0 {
+				return &validation.ValidationError{
+					Field:   idx.field,
+					Message: fmt.Sprintf("node index %d of link %d is negative", node, i),
+				}
+			}
+			if nodes >= 0 && node >= nodes {
+				return &validation.ValidationError{
+					Field:   idx.field,
+					Message: fmt.Sprintf("node index %d of link %d is out of range for %d nodes", node, i, nodes),
+				}
+			}
+		}
+	}
+
+	for i, v := range l.Value {
+		if v < 0 {
+			return &validation.ValidationError{
+				Field:   "Link.Value",
+				Message: fmt.Sprintf("value of link %d is negative: %g", i, v),
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *Sankey) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&s.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type
+	m["type"] = "sankey"
+
+	if s.Node != nil {
+		m["node"] = s.Node
+	}
+	if s.Link != nil {
+		m["link"] = s.Link
+	}
+
+	// Layout Properties
+	if s.Orientation != "" {
+		m["orientation"] = s.Orientation
+	}
+	if s.Arrangement != "" {
+		m["arrangement"] = s.Arrangement
+	}
+	if s.Domain != nil {
+		m["domain"] = s.Domain
+	}
+
+	// Text and Hover Properties
+	if s.ValueFormat != "" {
+		m["valueformat"] = s.ValueFormat
+	}
+	if s.ValueSuffix != "" {
+		m["valuesuffix"] = s.ValueSuffix
+	}
+	if s.TextFont != nil {
+		m["textfont"] = s.TextFont
+	}
+	if s.HoverLabel != nil {
+		m["hoverlabel"] = s.HoverLabel
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,153 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSankeyValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Sankey)
+		expectedError string
+	}{
+		{
+			name:          "valid sankey",
+			setup:         func(s *Sankey) {},
+			expectedError: "",
+		},
+		{
+			name: "missing link",
+			setup: func(s *Sankey) {
+				s.Link = nil
+			},
+			expectedError: "link must be provided",
+		},
+		{
+			name: "source and target length mismatch",
+			setup: func(s *Sankey) {
+				s.Link.Target = []int{1, 2}
+			},
+			expectedError: "link source and target must have the same length (3 != 2)",
+		},
+		{
+			name: "value length mismatch",
+			setup: func(s *Sankey) {
+				s.Link.Value = []float64{10, 5}
+			},
+			expectedError: "2 values given for 3 links",
+		},
+		{
+			name: "target out of range",
+			setup: func(s *Sankey) {
+				s.Link.Target = []int{1, 2, 3}
+			},
+			expectedError: "node index 3 of link 2 is out of range for 3 nodes",
+		},
+		{
+			name: "negative source",
+			setup: func(s *Sankey) {
+				s.Link.Source = []int{0, -1, 1}
+			},
+			expectedError: "node index -1 of link 1 is negative",
+		},
+		{
+			name: "unlabeled nodes",
+			setup: func(s *Sankey) {
+				s.Node = nil
+				s.Link.Target = []int{1, 2, 7}
+			},
+			expectedError: "",
+		},
+		{
+			name: "negative value",
+			setup: func(s *Sankey) {
+				s.Link.Value = []float64{10, -5, 5}
+			},
+			expectedError: "value of link 1 is negative: -5",
+		},
+		{
+			name: "link color length mismatch",
+			setup: func(s *Sankey) {
+				s.Link.Color = []string{"red", "blue"}
+			},
+			expectedError: "2 colors given for 3 links",
+		},
+		{
+			name: "node position outside plot",
+			setup: func(s *Sankey) {
+				s.Node.X = []float64{0.1, 0.5, 1.2}
+				s.Node.Y = []float64{0.5, 0.5, 0.5}
+			},
+			expectedError: "position of node 2 must be within [0, 1]",
+		},
+		{
+			name: "node x without y",
+			setup: func(s *Sankey) {
+				s.Node.X = []float64{0.1, 0.5, 0.9}
+			},
+			expectedError: "node x and y must have the same length (3 != 0)",
+		},
+		{
+			name: "negative node pad",
+			setup: func(s *Sankey) {
+				s.Node.Pad = -1
+			},
+			expectedError: "node pad must be non-negative",
+		},
+		{
+			name: "invalid arrangement",
+			setup: func(s *Sankey) {
+				s.Arrangement = "grid"
+			},
+			expectedError: "invalid arrangement: grid",
+		},
+		{
+			name: "invalid orientation",
+			setup: func(s *Sankey) {
+				s.Orientation = "horizontal"
+			},
+			expectedError: "invalid orientation: horizontal",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSankey()
+			s.Node = &SankeyNode{Label: []string{"gateway", "auth", "orders"}}
+			s.Link = &SankeyLink{
+				Source: []int{0, 0, 1},
+				Target: []int{1, 2, 2},
+				Value:  []float64{10, 5, 5},
+			}
+			tt.setup(s)
+
+			err := s.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestSankeyMarshalJSON(t *testing.T) {
+	s := NewSankey()
+	s.Node = &SankeyNode{Label: []string{"gateway", "auth"}, Pad: 15}
+	s.Link = &SankeyLink{Source: []int{0}, Target: []int{1}, Value: []float64{10}}
+	s.ValueSuffix = " req/s"
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"sankey"`)
+	assert.Contains(t, jsonStr, `"node":{"label":["gateway","auth"],"pad":15}`)
+	assert.Contains(t, jsonStr, `"link":{"source":[0],"target":[1],"value":[10]}`)
+	assert.Contains(t, jsonStr, `"valuesuffix":" req/s"`)
+	assert.NotContains(t, jsonStr, `"orientation"`)
+}
@@ -56,6 +56,34 @@ func NewScatter() *Scatter {
 	}
 }
 
+// ToGL converts the scatter trace to a Scattergl trace with the same
+// attributes. The scatter trace is not modified. Extra, Line and Marker are
+// copied, while the data arrays are shared with the scatter trace.
+func (s *Scatter) ToGL() *Scattergl {
+	gl := &Scattergl{Scatter: *s}
+	gl.Type = "scattergl"
+
+	if s.Extra != nil {
+		gl.Extra = make(map[string]interface{}, len(s.Extra))
+		for k, v := range s.Extra {
+			gl.Extra[k] = v
+		}
+	}
+	if s.Line != nil {
+		line := *s.Line
+		gl.Line = &line
+	}
+	if s.Marker != nil {
+		marker := *s.Marker
+		if marker.Line != nil {
+			markerLine := *marker.Line
+			marker.Line = &markerLine
+		}
+		gl.Marker = &marker
+	}
+	return gl
+}
+
 // Validate implements the Validator interface
 func (s *Scatter) Validate() error {
 	if err := s.BaseTrace.Validate(); err != nil {
@@ -63,20 +91,8 @@ func (s *Scatter) Validate() error {
 	}
 
 	// Validate mode if specified
-	if s.Mode != "" {
-		validModes := map[string]bool{
-			string(ModeLines):        true,
-			string(ModeMarkers):      true,
-			string(ModeLinesMarkers): true,
-			string(ModeText):         true,
-			string(ModeNone):         true,
-		}
-		if !validModes[s.Mode] {
-			return &validation.ValidationError{
-				Field:   "Mode",
-				Message: fmt.Sprintf("invalid mode: %s", s.Mode),
-			}
-		}
+	if err := validateScatterMode(s.Mode); err != nil {
+		return err
 	}
 
 	// Validate that X and Y are present
@@ -90,13 +106,34 @@ func (s *Scatter) Validate() error {
 	return nil
 }
 
+// validateScatterMode validates the mode of scatter trace types
+func validateScatterMode(mode string) error {
+	if mode == "" {
+		return nil
+	}
+	validModes := map[string]bool{
+		string(ModeLines):        true,
+		string(ModeMarkers):      true,
+		string(ModeLinesMarkers): true,
+		string(ModeText):         true,
+		string(ModeNone):         true,
+	}
+	if !validModes[mode] {
+		return &validation.ValidationError{
+			Field:   "Mode",
+			Message: fmt.Sprintf("invalid mode: %s", mode),
+		}
+	}
+	return nil
+}
+
 // MarshalJSON implements the json.Marshaler interface
 func (s *Scatter) MarshalJSON() ([]byte, error) {
 	// Create a map to store all fields
 	m := make(map[string]interface{})
 
 	// Add base trace fields
-	baseData, err := json.Marshal(s.BaseTrace)
+	baseData, err := json.Marshal(&s.BaseTrace)
 	if err != nil {
 		return nil, err
 	}
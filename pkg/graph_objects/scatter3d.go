@@ -0,0 +1,218 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Scatter3d marker symbols, the subset of scatter symbols available in 3D
+const (
+	Symbol3dCircle      = "circle"
+	Symbol3dCircleOpen  = "circle-open"
+	Symbol3dCross       = "cross"
+	Symbol3dDiamond     = "diamond"
+	Symbol3dDiamondOpen = "diamond-open"
+	Symbol3dSquare      = "square"
+	Symbol3dSquareOpen  = "square-open"
+	Symbol3dX           = "x"
+)
+
+// Scatter3d represents a 3D scatter trace, drawn as points and lines in a
+// scene
+type Scatter3d struct {
+	BaseTrace
+	// Data
+	X    interface{} `json:"x,omitempty"`
+	Y    interface{} `json:"y,omitempty"`
+	Z    interface{} `json:"z,omitempty"`
+	Mode string      `json:"mode,omitempty"`
+
+	// Styling
+	Line        *ScatterLine   `json:"line,omitempty"` // Shape and Smoothing are not supported in 3D
+	Marker      *ScatterMarker `json:"marker,omitempty"`
+	ConnectGaps *bool          `json:"connectgaps,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	TextPosition  string      `json:"textposition,omitempty"`
+	TextFont      *Font       `json:"textfont,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	Scene       string `json:"scene,omitempty"` // e.g. "scene" or "scene2"
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// NewScatter3d creates a new 3D scatter trace
+func NewScatter3d() *Scatter3d {
+	return &Scatter3d{
+		BaseTrace: BaseTrace{
+			Type: "scatter3d",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (s *Scatter3d) Validate() error {
+	if err := s.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateScatterMode(s.Mode); err != nil {
+		return err
+	}
+
+	// Validate that X, Y and Z are present with one value per point
+	if s.X == nil || s.Y == nil || s.Z == nil {
+		return &validation.ValidationError{
+			Field:   "X/Y/Z",
+			Message: "X, Y and Z must be provided",
+		}
+	}
+	if err := validateCoordinateLengths(s.X, s.Y, s.Z); err != nil {
+		return err
+	}
+
+	if s.Marker != nil {
+		if symbol, ok := s.Marker.Symbol.(string); ok {
+			validSymbols := map[string]bool{
+				Symbol3dCircle:      true,
+				Symbol3dCircleOpen:  true,
+				Symbol3dCross:       true,
+				Symbol3dDiamond:     true,
+				Symbol3dDiamondOpen: true,
+				Symbol3dSquare:      true,
+				Symbol3dSquareOpen:  true,
+				Symbol3dX:           true,
+			}
+			if !validSymbols[symbol] {
+				return &validation.ValidationError{
+					Field:   "Marker.Symbol",
+					Message: fmt.Sprintf("invalid 3D marker symbol: %s", symbol),
+				}
+			}
+		}
+	}
+
+	if s.Line != nil {
+		if s.Line.Width < 0 {
+			return &validation.ValidationError{
+				Field:   "Line.Width",
+				Message: "line width must be non-negative",
+			}
+		}
+		validDash := map[string]bool{
+			DashSolid:       true,
+			DashDot:         true,
+			DashDash:        true,
+			DashLongDash:    true,
+			DashDashDot:     true,
+			DashLongDashDot: true,
+		}
+		if s.Line.Dash != "" && !validDash[s.Line.Dash] {
+			return &validation.ValidationError{
+				Field:   "Line.Dash",
+				Message: fmt.Sprintf("invalid dash pattern: %s", s.Line.Dash),
+			}
+		}
+	}
+	return nil
+}
+
+// validateCoordinateLengths checks that the x, y and z coordinates given as
+// arrays have the same length
+func validateCoordinateLengths(x, y, z interface{}) error {
+	lengths := []struct {
+		field string
+		value interface{}
+	}{
+		{"X", x},
+		{"Y", y},
+		{"Z", z},
+	}
+	points := -1
+	for _, l := range lengths {
+		length, ok := arrayLength(l.value)
+		if !ok {
+			continue
+		}
+		if points >= 0 && length != points {
+			return &validation.ValidationError{
+				Field:   "X/Y/Z",
+				Message: fmt.Sprintf("%s has %d values, expected %d", l.field, length, points),
+			}
+		}
+		points = length
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *Scatter3d) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&s.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and coordinates
+	m["type"] = "scatter3d"
+	m["x"] = s.X
+	m["y"] = s.Y
+	m["z"] = s.Z
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	if s.Mode != "" {
+		m["mode"] = s.Mode
+	}
+	if s.Line != nil {
+		m["line"] = s.Line
+	}
+	if s.Marker != nil {
+		m["marker"] = s.Marker
+	}
+	if s.ConnectGaps != nil {
+		m["connectgaps"] = *s.ConnectGaps
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", s.Text)
+	if s.TextPosition != "" {
+		m["textposition"] = s.TextPosition
+	}
+	if s.TextFont != nil {
+		m["textfont"] = s.TextFont
+	}
+	addIfNotEmpty("hovertext", s.HoverText)
+	if s.HoverTemplate != "" {
+		m["hovertemplate"] = s.HoverTemplate
+	}
+	if s.HoverLabel != nil {
+		m["hoverlabel"] = s.HoverLabel
+	}
+
+	// Layout Properties
+	if s.Scene != "" {
+		m["scene"] = s.Scene
+	}
+	if s.LegendGroup != "" {
+		m["legendgroup"] = s.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,103 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScatter3dValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Scatter3d)
+		expectedError string
+	}{
+		{
+			name:          "valid scatter3d",
+			setup:         func(s *Scatter3d) {},
+			expectedError: "",
+		},
+		{
+			name: "missing z",
+			setup: func(s *Scatter3d) {
+				s.Z = nil
+			},
+			expectedError: "X, Y and Z must be provided",
+		},
+		{
+			name: "coordinate length mismatch",
+			setup: func(s *Scatter3d) {
+				s.Z = []float64{1, 2}
+			},
+			expectedError: "Z has 2 values, expected 3",
+		},
+		{
+			name: "invalid mode",
+			setup: func(s *Scatter3d) {
+				s.Mode = "dots"
+			},
+			expectedError: "invalid mode: dots",
+		},
+		{
+			name: "3D marker symbol",
+			setup: func(s *Scatter3d) {
+				s.Marker = &ScatterMarker{Symbol: Symbol3dDiamondOpen}
+			},
+			expectedError: "",
+		},
+		{
+			name: "marker symbol not available in 3D",
+			setup: func(s *Scatter3d) {
+				s.Marker = &ScatterMarker{Symbol: "triangle-up"}
+			},
+			expectedError: "invalid 3D marker symbol: triangle-up",
+		},
+		{
+			name: "invalid line dash",
+			setup: func(s *Scatter3d) {
+				s.Line = &ScatterLine{Dash: "dotted"}
+			},
+			expectedError: "invalid dash pattern: dotted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewScatter3d()
+			s.X = []float64{1, 2, 3}
+			s.Y = []float64{4, 5, 6}
+			s.Z = []float64{7, 8, 9}
+			tt.setup(s)
+
+			err := s.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestScatter3dMarshalJSON(t *testing.T) {
+	s := NewScatter3d()
+	s.X = []float64{1, 2}
+	s.Y = []float64{3, 4}
+	s.Z = []float64{5, 6}
+	s.Mode = string(ModeLinesMarkers)
+	s.Marker = &ScatterMarker{Size: 4, Color: "#1f77b4"}
+	s.Scene = "scene2"
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"scatter3d"`)
+	assert.Contains(t, jsonStr, `"z":[5,6]`)
+	assert.Contains(t, jsonStr, `"mode":"lines+markers"`)
+	assert.Contains(t, jsonStr, `"marker":{"size":4,"color":"#1f77b4"}`)
+	assert.Contains(t, jsonStr, `"scene":"scene2"`)
+	assert.NotContains(t, jsonStr, `"line"`)
+}
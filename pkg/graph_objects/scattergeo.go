@@ -0,0 +1,194 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Scattergeo represents a scatter trace on a geographic map, drawn as points
+// and lines at longitude and latitude coordinates or at named locations
+type Scattergeo struct {
+	BaseTrace
+	// Data
+	Lon          interface{} `json:"lon,omitempty"`
+	Lat          interface{} `json:"lat,omitempty"`
+	Locations    []string    `json:"locations,omitempty"`    // used instead of Lon and Lat
+	LocationMode string      `json:"locationmode,omitempty"` // "ISO-3", "USA-states", "country names" or "geojson-id"
+	GeoJSON      interface{} `json:"geojson,omitempty"`      // *GeoJSON, a decoded document or a URL
+	FeatureIDKey string      `json:"featureidkey,omitempty"` // "id" or a property such as "properties.name"
+	Mode         string      `json:"mode,omitempty"`
+
+	// Styling
+	Line        *ScatterLine   `json:"line,omitempty"`
+	Marker      *ScatterMarker `json:"marker,omitempty"`
+	Fill        string         `json:"fill,omitempty"` // "none" or "toself"
+	FillColor   string         `json:"fillcolor,omitempty"`
+	ConnectGaps *bool          `json:"connectgaps,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	TextPosition  string      `json:"textposition,omitempty"`
+	TextFont      *Font       `json:"textfont,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	Geo         string `json:"geo,omitempty"` // e.g. "geo" or "geo2"
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// NewScattergeo creates a new geographic scatter trace
+func NewScattergeo() *Scattergeo {
+	return &Scattergeo{
+		BaseTrace: BaseTrace{
+			Type: "scattergeo",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (s *Scattergeo) Validate() error {
+	if err := s.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateScatterMode(s.Mode); err != nil {
+		return err
+	}
+
+	// Points are placed at coordinates or at locations
+	if s.Locations == nil {
+		if s.Lon == nil || s.Lat == nil {
+			return &validation.ValidationError{
+				Field:   "Lon/Lat",
+				Message: "Lon and Lat, or Locations, must be provided",
+			}
+		}
+		lonLength, lonOK := arrayLength(s.Lon)
+		latLength, latOK := arrayLength(s.Lat)
+		if lonOK && latOK && lonLength != latLength {
+			return &validation.ValidationError{
+				Field:   "Lon/Lat",
+				Message: fmt.Sprintf("lon and lat must have the same length (%d != %d)", lonLength, latLength),
+			}
+		}
+		if lon, ok := toFloat64Slice(s.Lon); ok {
+			for i := range lon {
+				if err := validateLonLat(fmt.Sprintf("Lon[%d]", i), &lon[i], nil); err != nil {
+					return err
+				}
+			}
+		}
+		if lat, ok := toFloat64Slice(s.Lat); ok {
+			for i := range lat {
+				if err := validateLonLat(fmt.Sprintf("Lat[%d]", i), nil, &lat[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if err := validateGeoLocations(s.Locations, s.LocationMode, s.GeoJSON, s.FeatureIDKey); err != nil {
+		return err
+	}
+
+	if s.Fill != "" && s.Fill != "none" && s.Fill != "toself" {
+		return &validation.ValidationError{
+			Field:   "Fill",
+			Message: fmt.Sprintf("invalid fill: %s", s.Fill),
+		}
+	}
+
+	if s.Line != nil && s.Line.Width < 0 {
+		return &validation.ValidationError{
+			Field:   "Line.Width",
+			Message: "line width must be non-negative",
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *Scattergeo) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&s.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type
+	m["type"] = "scattergeo"
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("lon", s.Lon)
+	addIfNotEmpty("lat", s.Lat)
+	if s.Locations != nil {
+		m["locations"] = s.Locations
+	}
+	if s.LocationMode != "" {
+		m["locationmode"] = s.LocationMode
+	}
+	addIfNotEmpty("geojson", s.GeoJSON)
+	if s.FeatureIDKey != "" {
+		m["featureidkey"] = s.FeatureIDKey
+	}
+	if s.Mode != "" {
+		m["mode"] = s.Mode
+	}
+
+	// Styling
+	if s.Line != nil {
+		m["line"] = s.Line
+	}
+	if s.Marker != nil {
+		m["marker"] = s.Marker
+	}
+	if s.Fill != "" {
+		m["fill"] = s.Fill
+	}
+	if s.FillColor != "" {
+		m["fillcolor"] = s.FillColor
+	}
+	if s.ConnectGaps != nil {
+		m["connectgaps"] = *s.ConnectGaps
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", s.Text)
+	if s.TextPosition != "" {
+		m["textposition"] = s.TextPosition
+	}
+	if s.TextFont != nil {
+		m["textfont"] = s.TextFont
+	}
+	addIfNotEmpty("hovertext", s.HoverText)
+	if s.HoverTemplate != "" {
+		m["hovertemplate"] = s.HoverTemplate
+	}
+	if s.HoverLabel != nil {
+		m["hoverlabel"] = s.HoverLabel
+	}
+
+	// Layout Properties
+	if s.Geo != "" {
+		m["geo"] = s.Geo
+	}
+	if s.LegendGroup != "" {
+		m["legendgroup"] = s.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
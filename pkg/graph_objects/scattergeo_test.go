@@ -0,0 +1,143 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScattergeoValidation(t *testing.T) {
+	regions, err := ParseGeoJSON([]byte(testRegionsGeoJSON))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		setup         func() *Scattergeo
+		expectedError string
+	}{
+		{
+			name: "valid coordinates",
+			setup: func() *Scattergeo {
+				s := NewScattergeo()
+				s.Lon = []float64{-0.13, 2.35, 13.4}
+				s.Lat = []float64{51.5, 48.86, 52.52}
+				s.Mode = string(ModeMarkers)
+				return s
+			},
+			expectedError: "",
+		},
+		{
+			name: "valid locations",
+			setup: func() *Scattergeo {
+				s := NewScattergeo()
+				s.Locations = []string{"FRA", "DEU"}
+				s.LocationMode = LocationModeISO3
+				return s
+			},
+			expectedError: "",
+		},
+		{
+			name: "valid geojson locations",
+			setup: func() *Scattergeo {
+				s := NewScattergeo()
+				s.Locations = []string{"eu-west"}
+				s.GeoJSON = regions
+				return s
+			},
+			expectedError: "",
+		},
+		{
+			name: "missing data",
+			setup: func() *Scattergeo {
+				s := NewScattergeo()
+				s.Lon = []float64{1, 2}
+				return s
+			},
+			expectedError: "Lon and Lat, or Locations, must be provided",
+		},
+		{
+			name: "mismatched lengths",
+			setup: func() *Scattergeo {
+				s := NewScattergeo()
+				s.Lon = []float64{1, 2}
+				s.Lat = []float64{3}
+				return s
+			},
+			expectedError: "lon and lat must have the same length (2 != 1)",
+		},
+		{
+			name: "longitude out of range",
+			setup: func() *Scattergeo {
+				s := NewScattergeo()
+				s.Lon = []float64{10, 200}
+				s.Lat = []float64{0, 0}
+				return s
+			},
+			expectedError: "longitude 200 must be between -180 and 180",
+		},
+		{
+			name: "invalid location mode",
+			setup: func() *Scattergeo {
+				s := NewScattergeo()
+				s.Locations = []string{"FR"}
+				s.LocationMode = "ISO-2"
+				return s
+			},
+			expectedError: "invalid location mode: ISO-2",
+		},
+		{
+			name: "unknown geojson location",
+			setup: func() *Scattergeo {
+				s := NewScattergeo()
+				s.Locations = []string{"eu-south"}
+				s.GeoJSON = regions
+				return s
+			},
+			expectedError: `location "eu-south" at index 0 does not match the id of any GeoJSON feature`,
+		},
+		{
+			name: "invalid fill",
+			setup: func() *Scattergeo {
+				s := NewScattergeo()
+				s.Lon = []float64{1, 2}
+				s.Lat = []float64{3, 4}
+				s.Fill = "tozeroy"
+				return s
+			},
+			expectedError: "invalid fill: tozeroy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.setup().Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestScattergeoMarshalJSON(t *testing.T) {
+	s := NewScattergeo()
+	s.Lon = []float64{-0.13, 2.35}
+	s.Lat = []float64{51.5, 48.86}
+	s.Mode = string(ModeMarkers)
+	s.Marker = &ScatterMarker{Size: 8}
+	s.Geo = "geo2"
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"scattergeo"`)
+	assert.Contains(t, jsonStr, `"lon":[-0.13,2.35]`)
+	assert.Contains(t, jsonStr, `"lat":[51.5,48.86]`)
+	assert.Contains(t, jsonStr, `"mode":"markers"`)
+	assert.Contains(t, jsonStr, `"geo":"geo2"`)
+	assert.NotContains(t, jsonStr, `"locations"`)
+}
@@ -0,0 +1,27 @@
+package graph_objects
+
+// Scattergl represents a scatter trace drawn with WebGL instead of SVG, which
+// stays responsive with hundreds of thousands or millions of points. It has
+// the same attributes and validation as Scatter.
+type Scattergl struct {
+	Scatter
+}
+
+// NewScattergl creates a new WebGL scatter trace
+func NewScattergl() *Scattergl {
+	return &Scattergl{
+		Scatter: Scatter{
+			BaseTrace: BaseTrace{
+				Type: "scattergl",
+			},
+		},
+	}
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *Scattergl) MarshalJSON() ([]byte, error) {
+	// Always include type
+	scatter := s.Scatter
+	scatter.Type = "scattergl"
+	return scatter.MarshalJSON()
+}
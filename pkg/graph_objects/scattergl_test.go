@@ -0,0 +1,109 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewScattergl(t *testing.T) {
+	scattergl := NewScattergl()
+	assert.Equal(t, "scattergl", scattergl.Type)
+}
+
+func TestScatterglValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func() *Scattergl
+		expectedError string
+	}{
+		{
+			name: "valid scattergl",
+			setup: func() *Scattergl {
+				s := NewScattergl()
+				s.X = []float64{1, 2, 3}
+				s.Y = []float64{4, 5, 6}
+				s.Mode = string(ModeMarkers)
+				return s
+			},
+		},
+		{
+			name: "missing y",
+			setup: func() *Scattergl {
+				s := NewScattergl()
+				s.X = []float64{1, 2, 3}
+				return s
+			},
+			expectedError: "X and Y must be provided",
+		},
+		{
+			name: "invalid mode",
+			setup: func() *Scattergl {
+				s := NewScattergl()
+				s.X = []float64{1, 2, 3}
+				s.Y = []float64{4, 5, 6}
+				s.Mode = "invalid"
+				return s
+			},
+			expectedError: "invalid mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.setup().Validate()
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScatterglMarshalJSON(t *testing.T) {
+	s := NewScattergl()
+	s.X = []float64{1, 2, 3}
+	s.Y = []float64{4, 5, 6}
+	s.Mode = string(ModeMarkers)
+	s.Marker = &ScatterMarker{Size: 3}
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"scattergl"`)
+	assert.Contains(t, jsonStr, `"x":[1,2,3]`)
+	assert.Contains(t, jsonStr, `"mode":"markers"`)
+	assert.Contains(t, jsonStr, `"marker":{"size":3}`)
+}
+
+func TestScatterToGL(t *testing.T) {
+	s := NewScatter()
+	s.Name = "points"
+	s.X = []float64{1, 2, 3}
+	s.Y = []float64{4, 5, 6}
+	s.Mode = string(ModeMarkers)
+	s.Marker = &ScatterMarker{Size: 4, Line: &MarkerLine{Width: 1}}
+	s.Line = &ScatterLine{Width: 2}
+	s.Extra = map[string]interface{}{"hovertemplate": "%{y}"}
+
+	gl := s.ToGL()
+	assert.Equal(t, "scattergl", gl.Type)
+	assert.Equal(t, "points", gl.Name)
+	assert.Equal(t, s.X, gl.X)
+	assert.Equal(t, string(ModeMarkers), gl.Mode)
+
+	// The original trace is left unchanged
+	assert.Equal(t, "scatter", s.Type)
+	gl.Extra["hovertemplate"] = "%{x}"
+	gl.Marker.Size = 8
+	gl.Marker.Line.Width = 3
+	gl.Line.Width = 5
+	assert.Equal(t, "%{y}", s.Extra["hovertemplate"])
+	assert.Equal(t, 4, s.Marker.Size)
+	assert.Equal(t, 1, s.Marker.Line.Width)
+	assert.Equal(t, 2.0, s.Line.Width)
+}
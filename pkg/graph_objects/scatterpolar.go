@@ -0,0 +1,167 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Scatterpolar fill modes
+const (
+	PolarFillNone   = "none"
+	PolarFillToSelf = "toself"
+	PolarFillToNext = "tonext"
+)
+
+// Scatterpolar represents a scatter trace in polar coordinates, drawn as
+// points and lines at radius R and angle Theta
+type Scatterpolar struct {
+	BaseTrace
+	// Data
+	R         interface{} `json:"r,omitempty"`
+	Theta     interface{} `json:"theta,omitempty"`     // angles, or categories on a category angular axis
+	ThetaUnit string      `json:"thetaunit,omitempty"` // "degrees" or "radians"
+	Mode      string      `json:"mode,omitempty"`
+
+	// Styling
+	Line        *ScatterLine   `json:"line,omitempty"`
+	Marker      *ScatterMarker `json:"marker,omitempty"`
+	Fill        string         `json:"fill,omitempty"` // "none", "toself" or "tonext"
+	FillColor   string         `json:"fillcolor,omitempty"`
+	ConnectGaps *bool          `json:"connectgaps,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	TextPosition  string      `json:"textposition,omitempty"`
+	TextFont      *Font       `json:"textfont,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	Subplot     string `json:"subplot,omitempty"` // e.g. "polar" or "polar2"
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// NewScatterpolar creates a new polar scatter trace
+func NewScatterpolar() *Scatterpolar {
+	return &Scatterpolar{
+		BaseTrace: BaseTrace{
+			Type: "scatterpolar",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (s *Scatterpolar) Validate() error {
+	if err := s.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateScatterMode(s.Mode); err != nil {
+		return err
+	}
+	if err := validatePolarData(s.R, s.Theta); err != nil {
+		return err
+	}
+	if err := validateThetaUnit("ThetaUnit", s.ThetaUnit); err != nil {
+		return err
+	}
+
+	validFills := map[string]bool{
+		PolarFillNone:   true,
+		PolarFillToSelf: true,
+		PolarFillToNext: true,
+	}
+	if s.Fill != "" && !validFills[s.Fill] {
+		return &validation.ValidationError{
+			Field:   "Fill",
+			Message: fmt.Sprintf("invalid fill: %s", s.Fill),
+		}
+	}
+
+	if s.Line != nil && s.Line.Width < 0 {
+		return &validation.ValidationError{
+			Field:   "Line.Width",
+			Message: "line width must be non-negative",
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *Scatterpolar) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&s.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type
+	m["type"] = "scatterpolar"
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("r", s.R)
+	addIfNotEmpty("theta", s.Theta)
+	if s.ThetaUnit != "" {
+		m["thetaunit"] = s.ThetaUnit
+	}
+	if s.Mode != "" {
+		m["mode"] = s.Mode
+	}
+
+	// Styling
+	if s.Line != nil {
+		m["line"] = s.Line
+	}
+	if s.Marker != nil {
+		m["marker"] = s.Marker
+	}
+	if s.Fill != "" {
+		m["fill"] = s.Fill
+	}
+	if s.FillColor != "" {
+		m["fillcolor"] = s.FillColor
+	}
+	if s.ConnectGaps != nil {
+		m["connectgaps"] = *s.ConnectGaps
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", s.Text)
+	if s.TextPosition != "" {
+		m["textposition"] = s.TextPosition
+	}
+	if s.TextFont != nil {
+		m["textfont"] = s.TextFont
+	}
+	addIfNotEmpty("hovertext", s.HoverText)
+	if s.HoverTemplate != "" {
+		m["hovertemplate"] = s.HoverTemplate
+	}
+	if s.HoverLabel != nil {
+		m["hoverlabel"] = s.HoverLabel
+	}
+
+	// Layout Properties
+	if s.Subplot != "" {
+		m["subplot"] = s.Subplot
+	}
+	if s.LegendGroup != "" {
+		m["legendgroup"] = s.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
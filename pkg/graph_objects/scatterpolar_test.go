@@ -0,0 +1,102 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScatterpolarValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Scatterpolar)
+		expectedError string
+	}{
+		{
+			name:          "valid scatterpolar",
+			setup:         func(s *Scatterpolar) {},
+			expectedError: "",
+		},
+		{
+			name: "missing r",
+			setup: func(s *Scatterpolar) {
+				s.R = nil
+			},
+			expectedError: "R must be provided",
+		},
+		{
+			name: "r and theta length mismatch",
+			setup: func(s *Scatterpolar) {
+				s.Theta = []float64{0, 90}
+			},
+			expectedError: "r and theta must have the same length (4 != 2)",
+		},
+		{
+			name: "radians",
+			setup: func(s *Scatterpolar) {
+				s.Theta = []float64{0, 1.57, 3.14, 4.71}
+				s.ThetaUnit = ThetaUnitRadians
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid theta unit",
+			setup: func(s *Scatterpolar) {
+				s.ThetaUnit = "gradians"
+			},
+			expectedError: "invalid theta unit: gradians",
+		},
+		{
+			name: "invalid mode",
+			setup: func(s *Scatterpolar) {
+				s.Mode = "area"
+			},
+			expectedError: "invalid mode: area",
+		},
+		{
+			name: "invalid fill",
+			setup: func(s *Scatterpolar) {
+				s.Fill = "tozeroy"
+			},
+			expectedError: "invalid fill: tozeroy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewScatterpolar()
+			s.R = []float64{1, 2, 3, 2}
+			s.Theta = []float64{0, 90, 180, 270}
+			tt.setup(s)
+
+			err := s.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestScatterpolarMarshalJSON(t *testing.T) {
+	s := NewScatterpolar()
+	s.R = []float64{1, 2}
+	s.Theta = []string{"N", "E"}
+	s.Mode = string(ModeLinesMarkers)
+	s.Fill = PolarFillToSelf
+	s.Subplot = "polar2"
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"scatterpolar"`)
+	assert.Contains(t, jsonStr, `"r":[1,2]`)
+	assert.Contains(t, jsonStr, `"theta":["N","E"]`)
+	assert.Contains(t, jsonStr, `"fill":"toself"`)
+	assert.Contains(t, jsonStr, `"subplot":"polar2"`)
+	assert.NotContains(t, jsonStr, `"thetaunit"`)
+}
@@ -0,0 +1,217 @@
+package graph_objects
+
+import (
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// AspectMode represents how the axes of a 3D scene are scaled
+type AspectMode string
+
+const (
+	AspectModeAuto   AspectMode = "auto"
+	AspectModeCube   AspectMode = "cube"
+	AspectModeData   AspectMode = "data"
+	AspectModeManual AspectMode = "manual"
+)
+
+// Camera projection types
+const (
+	ProjectionPerspective  = "perspective"
+	ProjectionOrthographic = "orthographic"
+)
+
+// Scene represents the 3D scene that Scatter3d, Surface and Mesh3d traces are
+// drawn in. Traces refer to additional scenes such as "scene2", which can be
+// set through Layout.Extra.
+type Scene struct {
+	XAxis       *SceneAxis   `json:"xaxis,omitempty"`
+	YAxis       *SceneAxis   `json:"yaxis,omitempty"`
+	ZAxis       *SceneAxis   `json:"zaxis,omitempty"`
+	Camera      *Camera      `json:"camera,omitempty"`
+	AspectMode  string       `json:"aspectmode,omitempty"`  // "auto", "cube", "data" or "manual"
+	AspectRatio *AspectRatio `json:"aspectratio,omitempty"` // used with the "manual" aspect mode
+	Domain      *Domain      `json:"domain,omitempty"`
+	BgColor     string       `json:"bgcolor,omitempty"`
+	DragMode    interface{}  `json:"dragmode,omitempty"` // "orbit", "turntable", "zoom", "pan" or false
+}
+
+// SceneAxis represents an axis of a 3D scene
+type SceneAxis struct {
+	Title           *Title        `json:"title,omitempty"`
+	Type            string        `json:"type,omitempty"`
+	Range           []interface{} `json:"range,omitempty"`
+	AutoRange       interface{}   `json:"autorange,omitempty"` // bool or "reversed"
+	Visible         *bool         `json:"visible,omitempty"`
+	ShowGrid        *bool         `json:"showgrid,omitempty"`
+	GridColor       string        `json:"gridcolor,omitempty"`
+	GridWidth       float64       `json:"gridwidth,omitempty"`
+	ZeroLine        *bool         `json:"zeroline,omitempty"`
+	ZeroLineColor   string        `json:"zerolinecolor,omitempty"`
+	ShowLine        *bool         `json:"showline,omitempty"`
+	LineColor       string        `json:"linecolor,omitempty"`
+	LineWidth       float64       `json:"linewidth,omitempty"`
+	ShowBackground  *bool         `json:"showbackground,omitempty"`
+	BackgroundColor string        `json:"backgroundcolor,omitempty"`
+	ShowSpikes      *bool         `json:"showspikes,omitempty"`
+	TickFormat      string        `json:"tickformat,omitempty"`
+	TickFont        *Font         `json:"tickfont,omitempty"`
+	NTicks          int           `json:"nticks,omitempty"`
+}
+
+// Camera represents the view of a 3D scene. Eye is the position of the
+// camera, Center the point it looks at and Up the upward direction.
+type Camera struct {
+	Eye        *Vector3          `json:"eye,omitempty"`
+	Center     *Vector3          `json:"center,omitempty"`
+	Up         *Vector3          `json:"up,omitempty"`
+	Projection *CameraProjection `json:"projection,omitempty"`
+}
+
+// Vector3 represents a point or direction in a 3D scene
+type Vector3 struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// CameraProjection represents the projection of a scene camera
+type CameraProjection struct {
+	Type string `json:"type,omitempty"` // "perspective" or "orthographic"
+}
+
+// AspectRatio represents the relative lengths of the axes of a 3D scene
+type AspectRatio struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// validate checks the axes, camera and aspect settings of a scene
+func (s *Scene) validate(field string) error {
+	axes := []struct {
+		field string
+		axis  *SceneAxis
+	}{
+		{field + ".XAxis", s.XAxis},
+		{field + ".YAxis", s.YAxis},
+		{field + ".ZAxis", s.ZAxis},
+	}
+	for _, a := range axes {
+		if a.axis == nil {
+			continue
+		}
+		if err := a.axis.validate(a.field); err != nil {
+			return err
+		}
+	}
+
+	if s.AspectMode != "" {
+		validModes := map[string]bool{
+			string(AspectModeAuto):   true,
+			string(AspectModeCube):   true,
+			string(AspectModeData):   true,
+			string(AspectModeManual): true,
+		}
+		if !validModes[s.AspectMode] {
+			return &validation.ValidationError{
+				Field:   field + ".AspectMode",
+				Message: fmt.Sprintf("invalid aspect mode: %s", s.AspectMode),
+			}
+		}
+	}
+	if s.AspectMode == string(AspectModeManual) && s.AspectRatio == nil {
+		return &validation.ValidationError{
+			Field:   field + ".AspectRatio",
+			Message: "aspect ratio must be provided with the manual aspect mode",
+		}
+	}
+	if r := s.AspectRatio; r != nil && (r.X <= 0 || r.Y <= 0 || r.Z <= 0) {
+		return &validation.ValidationError{
+			Field:   field + ".AspectRatio",
+			Message: "aspect ratio must be positive",
+		}
+	}
+
+	if s.Camera != nil && s.Camera.Projection != nil {
+		projection := s.Camera.Projection.Type
+		if projection != "" && projection != ProjectionPerspective && projection != ProjectionOrthographic {
+			return &validation.ValidationError{
+				Field:   field + ".Camera.Projection.Type",
+				Message: fmt.Sprintf("invalid projection type: %s", projection),
+			}
+		}
+	}
+
+	switch v := s.DragMode.(type) {
+	case nil:
+	case bool:
+		if v {
+			return &validation.ValidationError{
+				Field:   field + ".DragMode",
+				Message: "drag mode can only be disabled with false",
+			}
+		}
+	case string:
+		validDragModes := map[string]bool{
+			"orbit":     true,
+			"turntable": true,
+			"zoom":      true,
+			"pan":       true,
+		}
+		if !validDragModes[v] {
+			return &validation.ValidationError{
+				Field:   field + ".DragMode",
+				Message: fmt.Sprintf("invalid drag mode: %s", v),
+			}
+		}
+	default:
+		return &validation.ValidationError{
+			Field:   field + ".DragMode",
+			Message: "drag mode must be a string or false",
+		}
+	}
+
+	if s.Domain != nil {
+		return s.Domain.validate()
+	}
+	return nil
+}
+
+func (a *SceneAxis) validate(field string) error {
+	if a.Type != "" {
+		validTypes := map[string]bool{
+			string(AxisTypeAuto):     true,
+			string(AxisTypeLinear):   true,
+			string(AxisTypeLog):      true,
+			string(AxisTypeDate):     true,
+			string(AxisTypeCategory): true,
+		}
+		if !validTypes[a.Type] {
+			return &validation.ValidationError{
+				Field:   field + ".Type",
+				Message: fmt.Sprintf("invalid axis type: %s", a.Type),
+			}
+		}
+	}
+
+	if a.Range != nil && len(a.Range) != 2 {
+		return &validation.ValidationError{
+			Field:   field + ".Range",
+			Message: "range must have exactly two values",
+		}
+	}
+
+	if err := validateAxisLines(field, a.GridWidth, a.LineWidth, a.NTicks); err != nil {
+		return err
+	}
+
+	if a.Title != nil {
+		if err := validateLayoutFont(a.Title.Font, field+".Title.Font"); err != nil {
+			return err
+		}
+	}
+
+	return validateLayoutFont(a.TickFont, field+".TickFont")
+}
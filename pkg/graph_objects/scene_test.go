@@ -0,0 +1,97 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSceneValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		scene         *Scene
+		expectedError string
+	}{
+		{
+			name: "valid scene",
+			scene: &Scene{
+				XAxis:      &SceneAxis{Title: &Title{Text: "x"}, ShowBackground: Bool(true)},
+				Camera:     &Camera{Eye: &Vector3{X: 1.5, Y: 1.5, Z: 1}},
+				AspectMode: string(AspectModeCube),
+			},
+			expectedError: "",
+		},
+		{
+			name:          "invalid aspect mode",
+			scene:         &Scene{AspectMode: "square"},
+			expectedError: "invalid aspect mode: square",
+		},
+		{
+			name:          "manual aspect mode without ratio",
+			scene:         &Scene{AspectMode: string(AspectModeManual)},
+			expectedError: "aspect ratio must be provided with the manual aspect mode",
+		},
+		{
+			name:          "zero aspect ratio",
+			scene:         &Scene{AspectMode: string(AspectModeManual), AspectRatio: &AspectRatio{X: 1, Y: 1}},
+			expectedError: "aspect ratio must be positive",
+		},
+		{
+			name:          "invalid axis type",
+			scene:         &Scene{ZAxis: &SceneAxis{Type: "multicategory"}},
+			expectedError: "invalid axis type: multicategory",
+		},
+		{
+			name:          "invalid axis range",
+			scene:         &Scene{YAxis: &SceneAxis{Range: []interface{}{0}}},
+			expectedError: "range must have exactly two values",
+		},
+		{
+			name:          "invalid projection",
+			scene:         &Scene{Camera: &Camera{Projection: &CameraProjection{Type: "fisheye"}}},
+			expectedError: "invalid projection type: fisheye",
+		},
+		{
+			name:          "drag mode disabled",
+			scene:         &Scene{DragMode: false},
+			expectedError: "",
+		},
+		{
+			name:          "invalid drag mode",
+			scene:         &Scene{DragMode: "select"},
+			expectedError: "invalid drag mode: select",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Layout{Scene: tt.scene}).Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestSceneMarshalJSON(t *testing.T) {
+	layout := &Layout{
+		Scene: &Scene{
+			ZAxis:  &SceneAxis{Title: &Title{Text: "Height"}},
+			Camera: &Camera{Up: &Vector3{Z: 1}},
+		},
+	}
+
+	data, err := json.Marshal(layout)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"scene":{"zaxis":{"title":{"text":"Height"}},"camera":{"up":{"x":0,"y":0,"z":1}}}`)
+
+	// Scenes decode into the typed field rather than Extra
+	var decoded Layout
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "Height", decoded.Scene.ZAxis.Title.Text)
+	assert.Empty(t, decoded.Extra)
+}
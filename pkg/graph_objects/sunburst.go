@@ -0,0 +1,197 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Sunburst inside text orientations
+const (
+	InsideTextOrientationHorizontal = "horizontal"
+	InsideTextOrientationRadial     = "radial"
+	InsideTextOrientationTangential = "tangential"
+	InsideTextOrientationAuto       = "auto"
+)
+
+// Sunburst represents a sunburst trace, which draws a hierarchy as rings of
+// sectors around its roots
+type Sunburst struct {
+	BaseTrace
+	// Data
+	IDs          []string  `json:"ids,omitempty"`
+	Labels       []string  `json:"labels"`
+	Parents      []string  `json:"parents,omitempty"` // id of the parent of each node, "" for roots
+	Values       []float64 `json:"values,omitempty"`
+	BranchValues string    `json:"branchvalues,omitempty"` // "remainder" or "total"
+
+	// Layout Properties
+	Level                 string           `json:"level,omitempty"`    // id of the node shown as the root
+	MaxDepth              int              `json:"maxdepth,omitempty"` // number of levels shown, -1 for all
+	Sort                  *bool            `json:"sort,omitempty"`
+	Domain                *Domain          `json:"domain,omitempty"`
+	Marker                *HierarchyMarker `json:"marker,omitempty"`
+	Rotation              float64          `json:"rotation,omitempty"` // start angle in degrees
+	Leaf                  *HierarchyLeaf   `json:"leaf,omitempty"`
+	InsideTextOrientation string           `json:"insidetextorientation,omitempty"`
+
+	// Text and Hover Properties
+	Text            interface{} `json:"text,omitempty"`
+	TextInfo        string      `json:"textinfo,omitempty"` // flags such as "label+percent parent", or "none"
+	TextTemplate    string      `json:"texttemplate,omitempty"`
+	TextFont        *Font       `json:"textfont,omitempty"`
+	InsideTextFont  *Font       `json:"insidetextfont,omitempty"`
+	OutsideTextFont *Font       `json:"outsidetextfont,omitempty"`
+	HoverText       interface{} `json:"hovertext,omitempty"`
+	HoverTemplate   string      `json:"hovertemplate,omitempty"`
+	HoverLabel      *HoverLabel `json:"hoverlabel,omitempty"`
+}
+
+// NewSunburst creates a new sunburst trace
+func NewSunburst() *Sunburst {
+	return &Sunburst{
+		BaseTrace: BaseTrace{
+			Type: "sunburst",
+		},
+	}
+}
+
+// SetHierarchy sets the ids, labels, parents and values of the trace, as
+// built by HierarchyFromTree or HierarchyFromPaths
+func (s *Sunburst) SetHierarchy(data *HierarchyData) {
+	s.IDs = data.IDs
+	s.Labels = data.Labels
+	s.Parents = data.Parents
+	s.Values = data.Values
+}
+
+// Validate implements the Validator interface
+func (s *Sunburst) Validate() error {
+	if err := s.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateHierarchy(s.IDs, s.Labels, s.Parents, s.Values, s.BranchValues); err != nil {
+		return err
+	}
+	if err := validateHierarchyLayout(s.MaxDepth, s.Marker, s.Domain); err != nil {
+		return err
+	}
+	if s.Rotation < -360 || s.Rotation > 360 {
+		return &validation.ValidationError{
+			Field:   "Rotation",
+			Message: "rotation must be between -360 and 360 degrees",
+		}
+	}
+	if s.Leaf != nil {
+		if err := s.Leaf.validate(); err != nil {
+			return err
+		}
+	}
+	validOrientations := map[string]bool{
+		InsideTextOrientationHorizontal: true,
+		InsideTextOrientationRadial:     true,
+		InsideTextOrientationTangential: true,
+		InsideTextOrientationAuto:       true,
+	}
+	if s.InsideTextOrientation != "" && !validOrientations[s.InsideTextOrientation] {
+		return &validation.ValidationError{
+			Field:   "InsideTextOrientation",
+			Message: fmt.Sprintf("invalid inside text orientation: %s", s.InsideTextOrientation),
+		}
+	}
+
+	return validateHierarchyTextInfo(s.TextInfo)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *Sunburst) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&s.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and labels
+	m["type"] = "sunburst"
+	m["labels"] = s.Labels
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	if s.IDs != nil {
+		m["ids"] = s.IDs
+	}
+	if s.Parents != nil {
+		m["parents"] = s.Parents
+	}
+	if s.Values != nil {
+		m["values"] = s.Values
+	}
+	if s.BranchValues != "" {
+		m["branchvalues"] = s.BranchValues
+	}
+
+	// Layout Properties
+	if s.Level != "" {
+		m["level"] = s.Level
+	}
+	if s.MaxDepth != 0 {
+		m["maxdepth"] = s.MaxDepth
+	}
+	if s.Sort != nil {
+		m["sort"] = *s.Sort
+	}
+	if s.Domain != nil {
+		m["domain"] = s.Domain
+	}
+	if s.Marker != nil {
+		m["marker"] = s.Marker
+	}
+	if s.Rotation != 0 {
+		m["rotation"] = s.Rotation
+	}
+	if s.Leaf != nil {
+		m["leaf"] = s.Leaf
+	}
+	if s.InsideTextOrientation != "" {
+		m["insidetextorientation"] = s.InsideTextOrientation
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", s.Text)
+	if s.TextInfo != "" {
+		m["textinfo"] = s.TextInfo
+	}
+	if s.TextTemplate != "" {
+		m["texttemplate"] = s.TextTemplate
+	}
+	if s.TextFont != nil {
+		m["textfont"] = s.TextFont
+	}
+	if s.InsideTextFont != nil {
+		m["insidetextfont"] = s.InsideTextFont
+	}
+	if s.OutsideTextFont != nil {
+		m["outsidetextfont"] = s.OutsideTextFont
+	}
+	addIfNotEmpty("hovertext", s.HoverText)
+	if s.HoverTemplate != "" {
+		m["hovertemplate"] = s.HoverTemplate
+	}
+	if s.HoverLabel != nil {
+		m["hoverlabel"] = s.HoverLabel
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,118 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSunburstValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Sunburst)
+		expectedError string
+	}{
+		{
+			name:          "valid sunburst",
+			setup:         func(s *Sunburst) {},
+			expectedError: "",
+		},
+		{
+			name: "orphan parent",
+			setup: func(s *Sunburst) {
+				s.Parents = []string{"", "costs", "costs"}
+			},
+			expectedError: "parent costs of compute is not a node",
+		},
+		{
+			name: "children exceed total",
+			setup: func(s *Sunburst) {
+				s.BranchValues = BranchValuesTotal
+				s.Values = []float64{50, 40, 30}
+			},
+			expectedError: "children of total sum to 70, which exceeds its value 50",
+		},
+		{
+			name: "invalid max depth",
+			setup: func(s *Sunburst) {
+				s.MaxDepth = -2
+			},
+			expectedError: "max depth must be -1 for all levels or positive",
+		},
+		{
+			name: "invalid inside text orientation",
+			setup: func(s *Sunburst) {
+				s.InsideTextOrientation = "diagonal"
+			},
+			expectedError: "invalid inside text orientation: diagonal",
+		},
+		{
+			name: "leaf opacity out of range",
+			setup: func(s *Sunburst) {
+				s.Leaf = &HierarchyLeaf{Opacity: Float64(2)}
+			},
+			expectedError: "leaf opacity must be between 0 and 1",
+		},
+		{
+			name: "text info flags",
+			setup: func(s *Sunburst) {
+				s.TextInfo = "label+percent parent"
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid text info flag",
+			setup: func(s *Sunburst) {
+				s.TextInfo = "label+percent"
+			},
+			expectedError: "invalid textinfo flag: percent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSunburst()
+			s.Labels = []string{"total", "compute", "storage"}
+			s.Parents = []string{"", "total", "total"}
+			s.Values = []float64{100, 60, 40}
+			tt.setup(s)
+
+			err := s.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestSunburstMarshalJSON(t *testing.T) {
+	s := NewSunburst()
+	s.SetHierarchy(HierarchyFromTree(&HierarchyNode{
+		Label: "total",
+		Value: 100,
+		Children: []*HierarchyNode{
+			{Label: "compute", Value: 60},
+		},
+	}))
+	s.BranchValues = BranchValuesTotal
+	s.MaxDepth = 2
+	s.InsideTextOrientation = InsideTextOrientationRadial
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"sunburst"`)
+	assert.Contains(t, jsonStr, `"ids":["total","total/compute"]`)
+	assert.Contains(t, jsonStr, `"labels":["total","compute"]`)
+	assert.Contains(t, jsonStr, `"parents":["","total"]`)
+	assert.Contains(t, jsonStr, `"values":[100,60]`)
+	assert.Contains(t, jsonStr, `"branchvalues":"total"`)
+	assert.Contains(t, jsonStr, `"maxdepth":2`)
+	assert.Contains(t, jsonStr, `"insidetextorientation":"radial"`)
+	assert.NotContains(t, jsonStr, `"rotation"`)
+}
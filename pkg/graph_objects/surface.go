@@ -0,0 +1,363 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Surface represents a 3D surface trace, drawn from a matrix of heights in a
+// scene
+type Surface struct {
+	BaseTrace
+	// Data
+	Z            [][]float64 `json:"z"`                      // rows of heights, NaN for gaps
+	X            interface{} `json:"x,omitempty"`            // one coordinate per column, or a matrix like Z
+	Y            interface{} `json:"y,omitempty"`            // one coordinate per row, or a matrix like Z
+	SurfaceColor [][]float64 `json:"surfacecolor,omitempty"` // values colored instead of Z
+
+	// Surface Properties
+	Contours    *SurfaceContours `json:"contours,omitempty"`
+	HideSurface *bool            `json:"hidesurface,omitempty"` // draw only the contours
+	Lighting    *Lighting        `json:"lighting,omitempty"`
+	ConnectGaps *bool            `json:"connectgaps,omitempty"`
+
+	// Color Properties
+	ColorScale   interface{} `json:"colorscale,omitempty"` // name or [position, color] pairs
+	ReverseScale *bool       `json:"reversescale,omitempty"`
+	ShowScale    *bool       `json:"showscale,omitempty"`
+	CMin         *float64    `json:"cmin,omitempty"`
+	CMax         *float64    `json:"cmax,omitempty"`
+	CMid         *float64    `json:"cmid,omitempty"`
+	CAuto        *bool       `json:"cauto,omitempty"`
+	ColorBar     *ColorBar   `json:"colorbar,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	Scene       string `json:"scene,omitempty"` // e.g. "scene" or "scene2"
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// SurfaceContours represents the contour lines of a surface along each axis
+type SurfaceContours struct {
+	X *SurfaceContour `json:"x,omitempty"`
+	Y *SurfaceContour `json:"y,omitempty"`
+	Z *SurfaceContour `json:"z,omitempty"`
+}
+
+// SurfaceContour represents the contour lines of a surface along one axis
+type SurfaceContour struct {
+	Show           *bool                  `json:"show,omitempty"`
+	Start          *float64               `json:"start,omitempty"`
+	End            *float64               `json:"end,omitempty"`
+	Size           *float64               `json:"size,omitempty"`
+	Color          string                 `json:"color,omitempty"`
+	Width          float64                `json:"width,omitempty"` // 1-16
+	UseColorMap    *bool                  `json:"usecolormap,omitempty"`
+	Highlight      *bool                  `json:"highlight,omitempty"`
+	HighlightColor string                 `json:"highlightcolor,omitempty"`
+	HighlightWidth float64                `json:"highlightwidth,omitempty"` // 1-16
+	Project        *SurfaceContourProject `json:"project,omitempty"`
+}
+
+// SurfaceContourProject represents the projection of surface contours onto
+// the walls of the scene
+type SurfaceContourProject struct {
+	X *bool `json:"x,omitempty"`
+	Y *bool `json:"y,omitempty"`
+	Z *bool `json:"z,omitempty"`
+}
+
+// Lighting represents the lighting effects of surface and mesh traces
+type Lighting struct {
+	Ambient   *float64 `json:"ambient,omitempty"`   // 0-1
+	Diffuse   *float64 `json:"diffuse,omitempty"`   // 0-1
+	Specular  *float64 `json:"specular,omitempty"`  // 0-2
+	Roughness *float64 `json:"roughness,omitempty"` // 0-1
+	Fresnel   *float64 `json:"fresnel,omitempty"`   // 0-5
+}
+
+// NewSurface creates a new surface trace
+func NewSurface() *Surface {
+	return &Surface{
+		BaseTrace: BaseTrace{
+			Type: "surface",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (s *Surface) Validate() error {
+	if err := s.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	rows, columns, err := validateMatrix("Z", s.Z)
+	if err != nil {
+		return err
+	}
+	if err := validateSurfaceCoordinates("X", s.X, columns, rows, columns); err != nil {
+		return err
+	}
+	if err := validateSurfaceCoordinates("Y", s.Y, rows, rows, columns); err != nil {
+		return err
+	}
+	if s.SurfaceColor != nil {
+		if err := validateSurfaceCoordinates("SurfaceColor", s.SurfaceColor, -1, rows, columns); err != nil {
+			return err
+		}
+	}
+
+	if s.Contours != nil {
+		if err := s.Contours.validate(); err != nil {
+			return err
+		}
+	}
+	if s.Lighting != nil {
+		if err := s.Lighting.validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := validateColorScale("ColorScale", s.ColorScale); err != nil {
+		return err
+	}
+	return validateColorRange("CMin", s.CMin, "CMax", s.CMax)
+}
+
+// validateSurfaceCoordinates checks that surface coordinates hold one value
+// per column or row, or form a matrix matching the z matrix. A negative size
+// only allows a matrix.
+func validateSurfaceCoordinates(field string, coords interface{}, size, rows, columns int) error {
+	if coords == nil {
+		return nil
+	}
+	length, ok := arrayLength(coords)
+	if !ok {
+		return &validation.ValidationError{
+			Field:   field,
+			Message: "coordinates must be an array or a matrix",
+		}
+	}
+
+	// Rows of a matrix are arrays themselves
+	value := reflect.ValueOf(coords)
+	isMatrix := false
+	if length > 0 {
+		_, isMatrix = arrayLength(value.Index(0).Interface())
+	}
+	if !isMatrix {
+		if size < 0 || length != size {
+			return &validation.ValidationError{
+				Field:   field,
+				Message: fmt.Sprintf("%d coordinates do not match the %dx%d z matrix", length, rows, columns),
+			}
+		}
+		return nil
+	}
+
+	if length != rows {
+		return &validation.ValidationError{
+			Field:   field,
+			Message: fmt.Sprintf("matrix has %d rows, expected %d", length, rows),
+		}
+	}
+	for i := 0; i < length; i++ {
+		if n, _ := arrayLength(value.Index(i).Interface()); n != columns {
+			return &validation.ValidationError{
+				Field:   field,
+				Message: fmt.Sprintf("matrix row %d has %d values, expected %d", i, n, columns),
+			}
+		}
+	}
+	return nil
+}
+
+func (c *SurfaceContours) validate() error {
+	axes := []struct {
+		field   string
+		contour *SurfaceContour
+	}{
+		{"Contours.X", c.X},
+		{"Contours.Y", c.Y},
+		{"Contours.Z", c.Z},
+	}
+	for _, a := range axes {
+		if a.contour == nil {
+			continue
+		}
+		if err := a.contour.validate(a.field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *SurfaceContour) validate(field string) error {
+	if c.Size != nil && *c.Size <= 0 {
+		return &validation.ValidationError{
+			Field:   field + ".Size",
+			Message: "contour size must be positive",
+		}
+	}
+	if c.Start != nil && c.End != nil && *c.Start >= *c.End {
+		return &validation.ValidationError{
+			Field:   field,
+			Message: "contour start must be less than end",
+		}
+	}
+
+	widths := []struct {
+		field string
+		value float64
+	}{
+		{field + ".Width", c.Width},
+		{field + ".HighlightWidth", c.HighlightWidth},
+	}
+	for _, w := range widths {
+		if w.value != 0 && (w.value < 1 || w.value > 16) {
+			return &validation.ValidationError{
+				Field:   w.field,
+				Message: "contour width must be between 1 and 16",
+			}
+		}
+	}
+	return nil
+}
+
+func (l *Lighting) validate() error {
+	coefficients := []struct {
+		field string
+		name  string
+		value *float64
+		max   float64
+	}{
+		{"Lighting.Ambient", "ambient", l.Ambient, 1},
+		{"Lighting.Diffuse", "diffuse", l.Diffuse, 1},
+		{"Lighting.Specular", "specular", l.Specular, 2},
+		{"Lighting.Roughness", "roughness", l.Roughness, 1},
+		{"Lighting.Fresnel", "fresnel", l.Fresnel, 5},
+	}
+	for _, c := range coefficients {
+		if c.value != nil && (*c.value < 0 || *c.value > c.max) {
+			return &validation.ValidationError{
+				Field:   c.field,
+				Message: fmt.Sprintf("%s lighting must be between 0 and %g", c.name, c.max),
+			}
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, decoding gaps in
+// the z and surface color matrices as NaN
+func (s *Surface) UnmarshalJSON(data []byte) error {
+	type surface Surface
+	aux := struct {
+		*surface
+		Z            [][]*float64 `json:"z"`
+		SurfaceColor [][]*float64 `json:"surfacecolor"`
+	}{surface: (*surface)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	s.Z = matrixFromJSON(aux.Z)
+	s.SurfaceColor = matrixFromJSON(aux.SurfaceColor)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s *Surface) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&s.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and data
+	m["type"] = "surface"
+	m["z"] = matrixJSON(s.Z)
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("x", s.X)
+	addIfNotEmpty("y", s.Y)
+	if s.SurfaceColor != nil {
+		m["surfacecolor"] = matrixJSON(s.SurfaceColor)
+	}
+
+	// Surface Properties
+	if s.Contours != nil {
+		m["contours"] = s.Contours
+	}
+	if s.HideSurface != nil {
+		m["hidesurface"] = *s.HideSurface
+	}
+	if s.Lighting != nil {
+		m["lighting"] = s.Lighting
+	}
+	if s.ConnectGaps != nil {
+		m["connectgaps"] = *s.ConnectGaps
+	}
+
+	// Color Properties
+	addIfNotEmpty("colorscale", s.ColorScale)
+	if s.ReverseScale != nil {
+		m["reversescale"] = *s.ReverseScale
+	}
+	if s.ShowScale != nil {
+		m["showscale"] = *s.ShowScale
+	}
+	if s.CMin != nil {
+		m["cmin"] = *s.CMin
+	}
+	if s.CMax != nil {
+		m["cmax"] = *s.CMax
+	}
+	if s.CMid != nil {
+		m["cmid"] = *s.CMid
+	}
+	if s.CAuto != nil {
+		m["cauto"] = *s.CAuto
+	}
+	if s.ColorBar != nil {
+		m["colorbar"] = s.ColorBar
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", s.Text)
+	addIfNotEmpty("hovertext", s.HoverText)
+	if s.HoverTemplate != "" {
+		m["hovertemplate"] = s.HoverTemplate
+	}
+	if s.HoverLabel != nil {
+		m["hoverlabel"] = s.HoverLabel
+	}
+
+	// Layout Properties
+	if s.Scene != "" {
+		m["scene"] = s.Scene
+	}
+	if s.LegendGroup != "" {
+		m["legendgroup"] = s.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,143 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSurfaceValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Surface)
+		expectedError string
+	}{
+		{
+			name:          "valid surface",
+			setup:         func(s *Surface) {},
+			expectedError: "",
+		},
+		{
+			name: "missing z",
+			setup: func(s *Surface) {
+				s.Z = nil
+			},
+			expectedError: "z matrix must be provided",
+		},
+		{
+			name: "x coordinate count mismatch",
+			setup: func(s *Surface) {
+				s.X = []float64{0, 1}
+			},
+			expectedError: "2 coordinates do not match the 2x3 z matrix",
+		},
+		{
+			name: "coordinate matrices",
+			setup: func(s *Surface) {
+				s.X = [][]float64{{0, 1, 2}, {0, 1, 2}}
+				s.Y = [][]float64{{0, 0, 0}, {1, 1, 1}}
+			},
+			expectedError: "",
+		},
+		{
+			name: "coordinate matrix row mismatch",
+			setup: func(s *Surface) {
+				s.Y = [][]float64{{0, 0, 0}, {1, 1}}
+			},
+			expectedError: "matrix row 1 has 2 values, expected 3",
+		},
+		{
+			name: "surface color must be a matrix",
+			setup: func(s *Surface) {
+				s.SurfaceColor = [][]float64{{1, 2, 3}}
+			},
+			expectedError: "matrix has 1 rows, expected 2",
+		},
+		{
+			name: "contours",
+			setup: func(s *Surface) {
+				s.Contours = &SurfaceContours{
+					Z: &SurfaceContour{Show: Bool(true), UseColorMap: Bool(true), Project: &SurfaceContourProject{Z: Bool(true)}},
+				}
+			},
+			expectedError: "",
+		},
+		{
+			name: "contour start after end",
+			setup: func(s *Surface) {
+				s.Contours = &SurfaceContours{X: &SurfaceContour{Start: Float64(2), End: Float64(1)}}
+			},
+			expectedError: "contour start must be less than end",
+		},
+		{
+			name: "contour width out of range",
+			setup: func(s *Surface) {
+				s.Contours = &SurfaceContours{Z: &SurfaceContour{Width: 20}}
+			},
+			expectedError: "contour width must be between 1 and 16",
+		},
+		{
+			name: "lighting out of range",
+			setup: func(s *Surface) {
+				s.Lighting = &Lighting{Specular: Float64(3)}
+			},
+			expectedError: "specular lighting must be between 0 and 2",
+		},
+		{
+			name: "cmin above cmax",
+			setup: func(s *Surface) {
+				s.CMin = Float64(10)
+				s.CMax = Float64(5)
+			},
+			expectedError: "cmin must be less than cmax",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSurface()
+			s.Z = [][]float64{{1, 2, 3}, {2, 4, 6}}
+			s.X = []float64{0, 1, 2}
+			s.Y = []float64{0, 1}
+			tt.setup(s)
+
+			err := s.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestSurfaceMarshalJSON(t *testing.T) {
+	s := NewSurface()
+	s.Z = [][]float64{{1, math.NaN()}, {3, 4}}
+	s.ColorScale = ColorScaleViridis
+	s.Contours = &SurfaceContours{Z: &SurfaceContour{Show: Bool(true)}}
+	s.Lighting = &Lighting{Ambient: Float64(0.6)}
+
+	data, err := json.Marshal(s)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"surface"`)
+	assert.Contains(t, jsonStr, `"z":[[1,null],[3,4]]`)
+	assert.Contains(t, jsonStr, `"colorscale":"Viridis"`)
+	assert.Contains(t, jsonStr, `"contours":{"z":{"show":true}}`)
+	assert.Contains(t, jsonStr, `"lighting":{"ambient":0.6}`)
+	assert.NotContains(t, jsonStr, `"surfacecolor"`)
+
+	// Gaps decode as NaN
+	decoded, err := DecodeTrace(data)
+	assert.NoError(t, err)
+	surface, ok := decoded.(*Surface)
+	assert.True(t, ok)
+	assert.True(t, math.IsNaN(surface.Z[0][1]))
+	assert.Nil(t, surface.SurfaceColor)
+	assert.NoError(t, surface.Validate())
+}
@@ -0,0 +1,361 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Table cell alignments
+const (
+	TableAlignLeft   = "left"
+	TableAlignCenter = "center"
+	TableAlignRight  = "right"
+)
+
+// Table represents a table trace, drawn as a grid of header and cell values.
+// Cell values are given per column.
+type Table struct {
+	BaseTrace
+	// Data
+	Header      *TableHeader `json:"header,omitempty"`
+	Cells       *TableCells  `json:"cells,omitempty"`
+	ColumnOrder []int        `json:"columnorder,omitempty"` // the column drawn at each position
+	ColumnWidth interface{}  `json:"columnwidth,omitempty"` // number or one relative width per column
+
+	// Hover Properties
+	HoverLabel *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	Domain *Domain `json:"domain,omitempty"`
+}
+
+// TableHeader represents the header row of a table
+type TableHeader struct {
+	Values []interface{} `json:"values"` // one value per column, a string or lines of text
+	TableStyle
+}
+
+// TableCells represents the cells of a table
+type TableCells struct {
+	Values [][]interface{} `json:"values"` // columns of values
+	TableStyle
+}
+
+// TableStyle represents the formatting and styling of table headers and
+// cells. Most properties are given for all columns or one per column.
+type TableStyle struct {
+	Format interface{} `json:"format,omitempty"` // d3 number format, e.g. ",.2f"
+	Prefix interface{} `json:"prefix,omitempty"`
+	Suffix interface{} `json:"suffix,omitempty"`
+	Height float64     `json:"height,omitempty"`
+	Align  interface{} `json:"align,omitempty"` // "left", "center" or "right"
+	Line   *TableLine  `json:"line,omitempty"`
+	Fill   *TableFill  `json:"fill,omitempty"`
+	Font   *Font       `json:"font,omitempty"`
+}
+
+// TableLine represents the lines between table cells
+type TableLine struct {
+	Color interface{} `json:"color,omitempty"` // string or one per column
+	Width interface{} `json:"width,omitempty"` // number or one per column
+}
+
+// TableFill represents the background color of table cells
+type TableFill struct {
+	Color interface{} `json:"color,omitempty"` // string, one per column or columns of colors
+}
+
+// NewTable creates a new table trace
+func NewTable() *Table {
+	return &Table{
+		BaseTrace: BaseTrace{
+			Type: "table",
+		},
+	}
+}
+
+// NewTableFromRows creates a table trace from a header and rows of values,
+// such as the records read by encoding/csv. A nil header leaves the table
+// without a header row.
+func NewTableFromRows(header []string, rows [][]string) *Table {
+	t := NewTable()
+	if header != nil {
+		t.Header = &TableHeader{Values: make([]interface{}, len(header))}
+		for i, value := range header {
+			t.Header.Values[i] = value
+		}
+	}
+
+	columns := len(header)
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	t.Cells = &TableCells{Values: make([][]interface{}, columns)}
+	for _, row := range rows {
+		for j, value := range row {
+			t.Cells.Values[j] = append(t.Cells.Values[j], value)
+		}
+	}
+	return t
+}
+
+// NewTableFromStructs creates a table trace from a slice of structs or
+// struct pointers, with one row per element and one column per exported
+// field. The header is the field name, or the name set with a `table` struct
+// tag; fields tagged `table:"-"` are skipped.
+func NewTableFromStructs(rows interface{}) (*Table, error) {
+	value := reflect.ValueOf(rows)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, &validation.ValidationError{
+			Field:   "Rows",
+			Message: fmt.Sprintf("rows must be a slice of structs, got %T", rows),
+		}
+	}
+
+	elemType := value.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, &validation.ValidationError{
+			Field:   "Rows",
+			Message: fmt.Sprintf("rows must be a slice of structs, got %T", rows),
+		}
+	}
+
+	t := NewTable()
+	t.Header = &TableHeader{}
+	var fields []int
+	for i := 0; i < elemType.NumField(); i++ {
+		field := elemType.Field(i)
+		name := field.Tag.Get("table")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		t.Header.Values = append(t.Header.Values, name)
+		fields = append(fields, i)
+	}
+
+	t.Cells = &TableCells{Values: make([][]interface{}, len(fields))}
+	for j := range fields {
+		t.Cells.Values[j] = make([]interface{}, 0, value.Len())
+	}
+	for i := 0; i < value.Len(); i++ {
+		row := value.Index(i)
+		if row.Kind() == reflect.Ptr {
+			if row.IsNil() {
+				return nil, &validation.ValidationError{
+					Field:   "Rows",
+					Message: fmt.Sprintf("row %d is nil", i),
+				}
+			}
+			row = row.Elem()
+		}
+		for j, field := range fields {
+			t.Cells.Values[j] = append(t.Cells.Values[j], row.Field(field).Interface())
+		}
+	}
+	return t, nil
+}
+
+// Validate implements the Validator interface
+func (t *Table) Validate() error {
+	if err := t.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	// Validate that every column has the same number of cells
+	if t.Cells == nil || len(t.Cells.Values) == 0 {
+		return &validation.ValidationError{
+			Field:   "Cells.Values",
+			Message: "cell values must be provided",
+		}
+	}
+	columns := len(t.Cells.Values)
+	for i, column := range t.Cells.Values {
+		if len(column) != len(t.Cells.Values[0]) {
+			return &validation.ValidationError{
+				Field:   "Cells.Values",
+				Message: fmt.Sprintf("column %d has %d values, expected %d", i, len(column), len(t.Cells.Values[0])),
+			}
+		}
+	}
+	if err := t.Cells.validate("Cells", columns); err != nil {
+		return err
+	}
+
+	if t.Header != nil {
+		if len(t.Header.Values) != columns {
+			return &validation.ValidationError{
+				Field:   "Header.Values",
+				Message: fmt.Sprintf("header has %d values for %d columns", len(t.Header.Values), columns),
+			}
+		}
+		if err := t.Header.validate("Header", columns); err != nil {
+			return err
+		}
+	}
+
+	// Column order must be a permutation of the column indices
+	if t.ColumnOrder != nil {
+		if len(t.ColumnOrder) != columns {
+			return &validation.ValidationError{
+				Field:   "ColumnOrder",
+				Message: fmt.Sprintf("column order has %d values for %d columns", len(t.ColumnOrder), columns),
+			}
+		}
+		seen := make(map[int]bool)
+		for _, column := range t.ColumnOrder {
+			if column < 0 || column >= columns || seen[column] {
+				return &validation.ValidationError{
+					Field:   "ColumnOrder",
+					Message: fmt.Sprintf("column order must hold each column index from 0 to %d once", columns-1),
+				}
+			}
+			seen[column] = true
+		}
+	}
+
+	if err := validateTableColumns("ColumnWidth", t.ColumnWidth, columns, true); err != nil {
+		return err
+	}
+
+	if t.Domain != nil {
+		return t.Domain.validate()
+	}
+	return nil
+}
+
+// validate checks the alignment, height and lines of table headers and cells
+func (s *TableStyle) validate(field string, columns int) error {
+	if s.Height < 0 {
+		return &validation.ValidationError{
+			Field:   field + ".Height",
+			Message: "height must be non-negative",
+		}
+	}
+
+	aligns := []interface{}{s.Align}
+	if length, ok := arrayLength(s.Align); ok {
+		if length > columns {
+			return &validation.ValidationError{
+				Field:   field + ".Align",
+				Message: fmt.Sprintf("%d alignments given for %d columns", length, columns),
+			}
+		}
+		aligns = make([]interface{}, length)
+		value := reflect.ValueOf(s.Align)
+		for i := range aligns {
+			aligns[i] = value.Index(i).Interface()
+		}
+	}
+	for _, align := range aligns {
+		if align == nil {
+			continue
+		}
+		if align != TableAlignLeft && align != TableAlignCenter && align != TableAlignRight {
+			return &validation.ValidationError{
+				Field:   field + ".Align",
+				Message: fmt.Sprintf("invalid alignment: %v", align),
+			}
+		}
+	}
+
+	if s.Line != nil {
+		if err := validateTableColumns(field+".Line.Width", s.Line.Width, columns, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTableColumns validates a number given for all columns or as one
+// value per column, which must be non-negative, or positive when positive is
+// set
+func validateTableColumns(field string, v interface{}, columns int, positive bool) error {
+	if v == nil {
+		return nil
+	}
+	values, ok := toFloat64Slice(v)
+	if ok {
+		if len(values) != columns {
+			return &validation.ValidationError{
+				Field:   field,
+				Message: fmt.Sprintf("%d values given for %d columns", len(values), columns),
+			}
+		}
+	} else if value, isNumber := toFloat64(v); isNumber {
+		values = []float64{value}
+	} else {
+		return &validation.ValidationError{
+			Field:   field,
+			Message: "value must be a number or one number per column",
+		}
+	}
+
+	for _, value := range values {
+		if positive && value <= 0 {
+			return &validation.ValidationError{
+				Field:   field,
+				Message: "value must be positive",
+			}
+		}
+		if value < 0 {
+			return &validation.ValidationError{
+				Field:   field,
+				Message: "value must be non-negative",
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (t *Table) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&t.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type
+	m["type"] = "table"
+
+	if t.Header != nil {
+		m["header"] = t.Header
+	}
+	if t.Cells != nil {
+		m["cells"] = t.Cells
+	}
+	if t.ColumnOrder != nil {
+		m["columnorder"] = t.ColumnOrder
+	}
+	if t.ColumnWidth != nil {
+		m["columnwidth"] = t.ColumnWidth
+	}
+
+	// Hover Properties
+	if t.HoverLabel != nil {
+		m["hoverlabel"] = t.HoverLabel
+	}
+
+	// Layout Properties
+	if t.Domain != nil {
+		m["domain"] = t.Domain
+	}
+
+	return json.Marshal(m)
+}
@@ -0,0 +1,236 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testTable() *Table {
+	t := NewTable()
+	t.Header = &TableHeader{Values: []interface{}{"Region", "Requests"}}
+	t.Cells = &TableCells{Values: [][]interface{}{
+		{"EU West", "EU North", "US East"},
+		{24500, 9700, 31200},
+	}}
+	return t
+}
+
+func TestTableValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func() *Table
+		expectedError string
+	}{
+		{
+			name:          "valid table",
+			setup:         testTable,
+			expectedError: "",
+		},
+		{
+			name: "valid styling",
+			setup: func() *Table {
+				table := testTable()
+				table.ColumnOrder = []int{1, 0}
+				table.ColumnWidth = []float64{2, 1}
+				table.Header.Align = TableAlignCenter
+				table.Header.Fill = &TableFill{Color: "#636efa"}
+				table.Header.Font = &Font{Color: "white", Size: 14}
+				table.Cells.Align = []string{TableAlignLeft, TableAlignRight}
+				table.Cells.Format = []interface{}{nil, ",d"}
+				table.Cells.Height = 28
+				table.Cells.Line = &TableLine{Color: "#e5ecf6", Width: 1}
+				return table
+			},
+			expectedError: "",
+		},
+		{
+			name: "without header",
+			setup: func() *Table {
+				table := testTable()
+				table.Header = nil
+				return table
+			},
+			expectedError: "",
+		},
+		{
+			name: "missing cells",
+			setup: func() *Table {
+				table := NewTable()
+				table.Header = &TableHeader{Values: []interface{}{"a"}}
+				return table
+			},
+			expectedError: "cell values must be provided",
+		},
+		{
+			name: "ragged columns",
+			setup: func() *Table {
+				table := testTable()
+				table.Cells.Values[1] = []interface{}{24500, 9700}
+				return table
+			},
+			expectedError: "column 1 has 2 values, expected 3",
+		},
+		{
+			name: "header count mismatch",
+			setup: func() *Table {
+				table := testTable()
+				table.Header.Values = []interface{}{"Region"}
+				return table
+			},
+			expectedError: "header has 1 values for 2 columns",
+		},
+		{
+			name: "column order length",
+			setup: func() *Table {
+				table := testTable()
+				table.ColumnOrder = []int{0}
+				return table
+			},
+			expectedError: "column order has 1 values for 2 columns",
+		},
+		{
+			name: "column order repeats a column",
+			setup: func() *Table {
+				table := testTable()
+				table.ColumnOrder = []int{1, 1}
+				return table
+			},
+			expectedError: "column order must hold each column index from 0 to 1 once",
+		},
+		{
+			name: "column widths",
+			setup: func() *Table {
+				table := testTable()
+				table.ColumnWidth = []float64{1, 2, 3}
+				return table
+			},
+			expectedError: "3 values given for 2 columns",
+		},
+		{
+			name: "zero column width",
+			setup: func() *Table {
+				table := testTable()
+				table.ColumnWidth = 0
+				return table
+			},
+			expectedError: "value must be positive",
+		},
+		{
+			name: "invalid alignment",
+			setup: func() *Table {
+				table := testTable()
+				table.Cells.Align = []string{TableAlignLeft, "justify"}
+				return table
+			},
+			expectedError: "invalid alignment: justify",
+		},
+		{
+			name: "negative height",
+			setup: func() *Table {
+				table := testTable()
+				table.Header.Height = -1
+				return table
+			},
+			expectedError: "height must be non-negative",
+		},
+		{
+			name: "negative line width",
+			setup: func() *Table {
+				table := testTable()
+				table.Cells.Line = &TableLine{Width: -1}
+				return table
+			},
+			expectedError: "value must be non-negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.setup().Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestNewTableFromRows(t *testing.T) {
+	table := NewTableFromRows(
+		[]string{"Region", "Requests"},
+		[][]string{
+			{"EU West", "24500"},
+			{"EU North", "9700"},
+		},
+	)
+	assert.Equal(t, "table", table.Type)
+	assert.Equal(t, []interface{}{"Region", "Requests"}, table.Header.Values)
+	assert.Equal(t, [][]interface{}{{"EU West", "EU North"}, {"24500", "9700"}}, table.Cells.Values)
+	assert.NoError(t, table.Validate())
+
+	// Without a header
+	assert.Nil(t, NewTableFromRows(nil, [][]string{{"a"}}).Header)
+
+	// Short rows leave columns with different lengths
+	ragged := NewTableFromRows([]string{"a", "b"}, [][]string{{"1", "2"}, {"3"}})
+	assert.EqualError(t, ragged.Validate(), "validation error for Cells.Values: column 1 has 1 values, expected 2")
+}
+
+func TestNewTableFromStructs(t *testing.T) {
+	type regionStats struct {
+		Region   string
+		Requests int     `table:"Requests/day"`
+		Latency  float64 `table:"p99 (ms)"`
+		internal string
+		Owner    string `table:"-"`
+	}
+	rows := []regionStats{
+		{Region: "EU West", Requests: 24500, Latency: 182.5, internal: "x", Owner: "a"},
+		{Region: "EU North", Requests: 9700, Latency: 210, internal: "y", Owner: "b"},
+	}
+
+	table, err := NewTableFromStructs(rows)
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{"Region", "Requests/day", "p99 (ms)"}, table.Header.Values)
+	assert.Equal(t, [][]interface{}{
+		{"EU West", "EU North"},
+		{24500, 9700},
+		{182.5, 210.0},
+	}, table.Cells.Values)
+	assert.NoError(t, table.Validate())
+
+	// Pointers to structs
+	pointers, err := NewTableFromStructs([]*regionStats{&rows[0]})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{"EU West"}, {24500}, {182.5}}, pointers.Cells.Values)
+
+	_, err = NewTableFromStructs([]*regionStats{nil})
+	assert.EqualError(t, err, "validation error for Rows: row 0 is nil")
+
+	_, err = NewTableFromStructs([]string{"a"})
+	assert.EqualError(t, err, "validation error for Rows: rows must be a slice of structs, got []string")
+
+	_, err = NewTableFromStructs(rows[0])
+	assert.Error(t, err)
+}
+
+func TestTableMarshalJSON(t *testing.T) {
+	table := testTable()
+	table.ColumnOrder = []int{1, 0}
+	table.Header.Fill = &TableFill{Color: "#636efa"}
+	table.Cells.Format = []interface{}{"", ",d"}
+	table.Cells.Suffix = []string{"", " req"}
+
+	data, err := json.Marshal(table)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"table"`)
+	assert.Contains(t, jsonStr, `"header":{"values":["Region","Requests"],"fill":{"color":"#636efa"}}`)
+	assert.Contains(t, jsonStr, `"cells":{"values":[["EU West","EU North","US East"],[24500,9700,31200]],"format":["",",d"],"suffix":[""," req"]}`)
+	assert.Contains(t, jsonStr, `"columnorder":[1,0]`)
+}
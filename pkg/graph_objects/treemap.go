@@ -0,0 +1,219 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Treemap packing algorithms
+const (
+	PackingSquarify  = "squarify"
+	PackingBinary    = "binary"
+	PackingDice      = "dice"
+	PackingSlice     = "slice"
+	PackingSliceDice = "slice-dice"
+	PackingDiceSlice = "dice-slice"
+)
+
+// Treemap represents a treemap trace, which draws a hierarchy as nested
+// rectangles
+type Treemap struct {
+	BaseTrace
+	// Data
+	IDs          []string  `json:"ids,omitempty"`
+	Labels       []string  `json:"labels"`
+	Parents      []string  `json:"parents,omitempty"` // id of the parent of each node, "" for roots
+	Values       []float64 `json:"values,omitempty"`
+	BranchValues string    `json:"branchvalues,omitempty"` // "remainder" or "total"
+
+	// Layout Properties
+	Level    string           `json:"level,omitempty"`    // id of the node shown as the root
+	MaxDepth int              `json:"maxdepth,omitempty"` // number of levels shown, -1 for all
+	Sort     *bool            `json:"sort,omitempty"`
+	Domain   *Domain          `json:"domain,omitempty"`
+	Marker   *HierarchyMarker `json:"marker,omitempty"`
+	Tiling   *TreemapTiling   `json:"tiling,omitempty"`
+	PathBar  *PathBar         `json:"pathbar,omitempty"`
+
+	// Text and Hover Properties
+	Text            interface{} `json:"text,omitempty"`
+	TextInfo        string      `json:"textinfo,omitempty"`     // flags such as "label+percent parent", or "none"
+	TextPosition    string      `json:"textposition,omitempty"` // e.g. "top left" or "middle center"
+	TextTemplate    string      `json:"texttemplate,omitempty"`
+	TextFont        *Font       `json:"textfont,omitempty"`
+	InsideTextFont  *Font       `json:"insidetextfont,omitempty"`
+	OutsideTextFont *Font       `json:"outsidetextfont,omitempty"`
+	HoverText       interface{} `json:"hovertext,omitempty"`
+	HoverTemplate   string      `json:"hovertemplate,omitempty"`
+	HoverLabel      *HoverLabel `json:"hoverlabel,omitempty"`
+}
+
+// TreemapTiling represents how a treemap divides a rectangle between the
+// children of a node
+type TreemapTiling struct {
+	Packing       string  `json:"packing,omitempty"`
+	SquarifyRatio float64 `json:"squarifyratio,omitempty"` // aspect ratio of the squarify packing
+	Flip          string  `json:"flip,omitempty"`          // "x", "y" or "x+y"
+	Pad           float64 `json:"pad,omitempty"`           // padding between nodes in pixels
+}
+
+func (t *TreemapTiling) validate() error {
+	validPackings := map[string]bool{
+		PackingSquarify:  true,
+		PackingBinary:    true,
+		PackingDice:      true,
+		PackingSlice:     true,
+		PackingSliceDice: true,
+		PackingDiceSlice: true,
+	}
+	if t.Packing != "" && !validPackings[t.Packing] {
+		return &validation.ValidationError{
+			Field:   "Tiling.Packing",
+			Message: fmt.Sprintf("invalid packing: %s", t.Packing),
+		}
+	}
+	if t.SquarifyRatio != 0 && t.SquarifyRatio < 1 {
+		return &validation.ValidationError{
+			Field:   "Tiling.SquarifyRatio",
+			Message: "squarify ratio must be at least 1",
+		}
+	}
+	return validateTiling(t.Flip, t.Pad)
+}
+
+// NewTreemap creates a new treemap trace
+func NewTreemap() *Treemap {
+	return &Treemap{
+		BaseTrace: BaseTrace{
+			Type: "treemap",
+		},
+	}
+}
+
+// SetHierarchy sets the ids, labels, parents and values of the trace, as
+// built by HierarchyFromTree or HierarchyFromPaths
+func (t *Treemap) SetHierarchy(data *HierarchyData) {
+	t.IDs = data.IDs
+	t.Labels = data.Labels
+	t.Parents = data.Parents
+	t.Values = data.Values
+}
+
+// Validate implements the Validator interface
+func (t *Treemap) Validate() error {
+	if err := t.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateHierarchy(t.IDs, t.Labels, t.Parents, t.Values, t.BranchValues); err != nil {
+		return err
+	}
+	if err := validateHierarchyLayout(t.MaxDepth, t.Marker, t.Domain); err != nil {
+		return err
+	}
+	if t.Tiling != nil {
+		if err := t.Tiling.validate(); err != nil {
+			return err
+		}
+	}
+	if t.PathBar != nil {
+		if err := t.PathBar.validate(); err != nil {
+			return err
+		}
+	}
+
+	return validateHierarchyTextInfo(t.TextInfo)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (t *Treemap) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&t.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type and labels
+	m["type"] = "treemap"
+	m["labels"] = t.Labels
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	if t.IDs != nil {
+		m["ids"] = t.IDs
+	}
+	if t.Parents != nil {
+		m["parents"] = t.Parents
+	}
+	if t.Values != nil {
+		m["values"] = t.Values
+	}
+	if t.BranchValues != "" {
+		m["branchvalues"] = t.BranchValues
+	}
+
+	// Layout Properties
+	if t.Level != "" {
+		m["level"] = t.Level
+	}
+	if t.MaxDepth != 0 {
+		m["maxdepth"] = t.MaxDepth
+	}
+	if t.Sort != nil {
+		m["sort"] = *t.Sort
+	}
+	if t.Domain != nil {
+		m["domain"] = t.Domain
+	}
+	if t.Marker != nil {
+		m["marker"] = t.Marker
+	}
+	if t.Tiling != nil {
+		m["tiling"] = t.Tiling
+	}
+	if t.PathBar != nil {
+		m["pathbar"] = t.PathBar
+	}
+	if t.TextPosition != "" {
+		m["textposition"] = t.TextPosition
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", t.Text)
+	if t.TextInfo != "" {
+		m["textinfo"] = t.TextInfo
+	}
+	if t.TextTemplate != "" {
+		m["texttemplate"] = t.TextTemplate
+	}
+	if t.TextFont != nil {
+		m["textfont"] = t.TextFont
+	}
+	if t.InsideTextFont != nil {
+		m["insidetextfont"] = t.InsideTextFont
+	}
+	if t.OutsideTextFont != nil {
+		m["outsidetextfont"] = t.OutsideTextFont
+	}
+	addIfNotEmpty("hovertext", t.HoverText)
+	if t.HoverTemplate != "" {
+		m["hovertemplate"] = t.HoverTemplate
+	}
+	if t.HoverLabel != nil {
+		m["hoverlabel"] = t.HoverLabel
+	}
+
+	return json.Marshal(m)
+}
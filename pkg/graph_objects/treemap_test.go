@@ -0,0 +1,102 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreemapValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Treemap)
+		expectedError string
+	}{
+		{
+			name:          "valid treemap",
+			setup:         func(tm *Treemap) {},
+			expectedError: "",
+		},
+		{
+			name: "cycle",
+			setup: func(tm *Treemap) {
+				tm.Parents = []string{"storage", "total", "compute"}
+			},
+			expectedError: "cycle in parents",
+		},
+		{
+			name: "invalid packing",
+			setup: func(tm *Treemap) {
+				tm.Tiling = &TreemapTiling{Packing: "spiral"}
+			},
+			expectedError: "invalid packing: spiral",
+		},
+		{
+			name: "squarify ratio below 1",
+			setup: func(tm *Treemap) {
+				tm.Tiling = &TreemapTiling{Packing: PackingSquarify, SquarifyRatio: 0.5}
+			},
+			expectedError: "squarify ratio must be at least 1",
+		},
+		{
+			name: "invalid flip",
+			setup: func(tm *Treemap) {
+				tm.Tiling = &TreemapTiling{Flip: "z"}
+			},
+			expectedError: "invalid tiling flip: z",
+		},
+		{
+			name: "invalid path bar side",
+			setup: func(tm *Treemap) {
+				tm.PathBar = &PathBar{Side: "left"}
+			},
+			expectedError: "invalid path bar side: left",
+		},
+		{
+			name: "invalid marker colorscale",
+			setup: func(tm *Treemap) {
+				tm.Marker = &HierarchyMarker{ColorScale: "Sunset"}
+			},
+			expectedError: "invalid colorscale name: Sunset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := NewTreemap()
+			tm.Labels = []string{"total", "compute", "storage"}
+			tm.Parents = []string{"", "total", "total"}
+			tm.Values = []float64{0, 60, 40}
+			tt.setup(tm)
+
+			err := tm.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestTreemapMarshalJSON(t *testing.T) {
+	tm := NewTreemap()
+	tm.Labels = []string{"total", "compute"}
+	tm.Parents = []string{"", "total"}
+	tm.Tiling = &TreemapTiling{Packing: PackingSliceDice, Pad: 2}
+	tm.PathBar = &PathBar{Visible: Bool(false)}
+	tm.TextPosition = "top left"
+
+	data, err := json.Marshal(tm)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"treemap"`)
+	assert.Contains(t, jsonStr, `"tiling":{"packing":"slice-dice","pad":2}`)
+	assert.Contains(t, jsonStr, `"pathbar":{"visible":false}`)
+	assert.Contains(t, jsonStr, `"textposition":"top left"`)
+	assert.NotContains(t, jsonStr, `"values"`)
+	assert.NotContains(t, jsonStr, `"ids"`)
+}
@@ -0,0 +1,361 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Violin sides
+const (
+	ViolinSideBoth     = "both"
+	ViolinSidePositive = "positive"
+	ViolinSideNegative = "negative"
+)
+
+// Violin span modes
+const (
+	SpanModeSoft   = "soft"
+	SpanModeHard   = "hard"
+	SpanModeManual = "manual"
+)
+
+// Violin scale modes
+const (
+	ScaleModeWidth = "width"
+	ScaleModeCount = "count"
+)
+
+// Violin hover on flags, combined with "+", e.g. "violins+points"
+const (
+	HoverOnViolins = "violins"
+	HoverOnKDE     = "kde"
+)
+
+// Violin represents a violin trace, which draws the kernel density estimate
+// of the samples, optionally with a box plot and sample points inside
+type Violin struct {
+	BaseTrace
+	// Data
+	X interface{} `json:"x,omitempty"`
+	Y interface{} `json:"y,omitempty"`
+
+	// Violin specific properties
+	Orientation    string        `json:"orientation,omitempty"`
+	Bandwidth      *float64      `json:"bandwidth,omitempty"` // kernel bandwidth, computed from the data when nil
+	Side           string        `json:"side,omitempty"`      // "both", "positive" or "negative"
+	Span           []interface{} `json:"span,omitempty"`      // [min, max] when SpanMode is "manual"
+	SpanMode       string        `json:"spanmode,omitempty"`
+	ScaleGroup     string        `json:"scalegroup,omitempty"`
+	ScaleMode      string        `json:"scalemode,omitempty"` // "width" or "count"
+	Width          float64       `json:"width,omitempty"`
+	QuartileMethod string        `json:"quartilemethod,omitempty"`
+
+	// Box, Mean Line and Points
+	Box      *ViolinBox      `json:"box,omitempty"`
+	MeanLine *ViolinMeanLine `json:"meanline,omitempty"`
+	Points   string          `json:"points,omitempty"` // "all", "outliers", "suspectedoutliers" or "false"
+	Jitter   float64         `json:"jitter,omitempty"`
+	PointPos float64         `json:"pointpos,omitempty"`
+
+	// Visual Properties
+	Marker     *BoxMarker  `json:"marker,omitempty"`
+	Line       *ViolinLine `json:"line,omitempty"`
+	FillColor  interface{} `json:"fillcolor,omitempty"`
+	Selected   *Selection  `json:"selected,omitempty"`
+	Unselected *Selection  `json:"unselected,omitempty"`
+
+	// Hover and Text Properties
+	Text          interface{} `json:"text,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+	HoverOn       string      `json:"hoveron,omitempty"` // flags such as "violins+points", or "all"
+
+	// Layout Properties
+	AlignmentGroup string `json:"alignmentgroup,omitempty"`
+	OffsetGroup    string `json:"offsetgroup,omitempty"`
+	XAxis          string `json:"xaxis,omitempty"`
+	YAxis          string `json:"yaxis,omitempty"`
+	LegendGroup    string `json:"legendgroup,omitempty"`
+}
+
+// ViolinBox represents the box plot drawn inside a violin
+type ViolinBox struct {
+	Visible   *bool       `json:"visible,omitempty"`
+	Width     float64     `json:"width,omitempty"` // fraction of the violin width
+	FillColor interface{} `json:"fillcolor,omitempty"`
+	Line      *ViolinLine `json:"line,omitempty"`
+}
+
+// ViolinMeanLine represents the line drawn at the sample mean of a violin
+type ViolinMeanLine struct {
+	Visible *bool       `json:"visible,omitempty"`
+	Color   interface{} `json:"color,omitempty"`
+	Width   float64     `json:"width,omitempty"`
+}
+
+// ViolinLine represents the outline of a violin or its box
+type ViolinLine struct {
+	Color interface{} `json:"color,omitempty"`
+	Width float64     `json:"width,omitempty"`
+}
+
+// NewViolin creates a new violin trace
+func NewViolin() *Violin {
+	return &Violin{
+		BaseTrace: BaseTrace{
+			Type: "violin",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (v *Violin) Validate() error {
+	if err := v.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	// Validate orientation and sample points
+	if err := validateSamplePoints("Points", v.Orientation, v.Points, v.Jitter, v.PointPos); err != nil {
+		return err
+	}
+
+	// Validate that X or Y is present
+	if v.X == nil && v.Y == nil {
+		return &validation.ValidationError{
+			Field:   "X/Y",
+			Message: "at least one of X or Y must be provided",
+		}
+	}
+
+	if err := validateQuartileMethod(v.QuartileMethod); err != nil {
+		return err
+	}
+
+	// Validate density estimate
+	if v.Bandwidth != nil && *v.Bandwidth <= 0 {
+		return &validation.ValidationError{
+			Field:   "Bandwidth",
+			Message: "bandwidth must be positive",
+		}
+	}
+	if v.Side != "" && v.Side != ViolinSideBoth && v.Side != ViolinSidePositive && v.Side != ViolinSideNegative {
+		return &validation.ValidationError{
+			Field:   "Side",
+			Message: fmt.Sprintf("invalid side: %s", v.Side),
+		}
+	}
+	if err := v.validateSpan(); err != nil {
+		return err
+	}
+
+	// Validate scaling
+	if v.ScaleMode != "" && v.ScaleMode != ScaleModeWidth && v.ScaleMode != ScaleModeCount {
+		return &validation.ValidationError{
+			Field:   "ScaleMode",
+			Message: fmt.Sprintf("invalid scale mode: %s", v.ScaleMode),
+		}
+	}
+	if v.Width < 0 {
+		return &validation.ValidationError{
+			Field:   "Width",
+			Message: "width must be non-negative",
+		}
+	}
+
+	// Validate box, mean line and outline
+	if v.Box != nil {
+		if v.Box.Width < 0 || v.Box.Width > 1 {
+			return &validation.ValidationError{
+				Field:   "Box.Width",
+				Message: "box width must be between 0 and 1",
+			}
+		}
+		if v.Box.Line != nil && v.Box.Line.Width < 0 {
+			return &validation.ValidationError{
+				Field:   "Box.Line.Width",
+				Message: "line width must be non-negative",
+			}
+		}
+	}
+	if v.MeanLine != nil && v.MeanLine.Width < 0 {
+		return &validation.ValidationError{
+			Field:   "MeanLine.Width",
+			Message: "mean line width must be non-negative",
+		}
+	}
+	if v.Line != nil && v.Line.Width < 0 {
+		return &validation.ValidationError{
+			Field:   "Line.Width",
+			Message: "line width must be non-negative",
+		}
+	}
+
+	if v.Marker != nil {
+		if err := validatePointMarker(v.Marker); err != nil {
+			return err
+		}
+	}
+
+	return v.validateHoverOn()
+}
+
+func (v *Violin) validateSpan() error {
+	if v.SpanMode != "" && v.SpanMode != SpanModeSoft && v.SpanMode != SpanModeHard && v.SpanMode != SpanModeManual {
+		return &validation.ValidationError{
+			Field:   "SpanMode",
+			Message: fmt.Sprintf("invalid span mode: %s", v.SpanMode),
+		}
+	}
+	if v.SpanMode == SpanModeManual && len(v.Span) != 2 {
+		return &validation.ValidationError{
+			Field:   "Span",
+			Message: "manual span mode requires a [min, max] span",
+		}
+	}
+	if len(v.Span) == 2 {
+		low, lowOK := toFloat64(v.Span[0])
+		high, highOK := toFloat64(v.Span[1])
+		if lowOK && highOK && low >= high {
+			return &validation.ValidationError{
+				Field:   "Span",
+				Message: "span minimum must be less than maximum",
+			}
+		}
+	}
+	return nil
+}
+
+func (v *Violin) validateHoverOn() error {
+	if v.HoverOn == "" || v.HoverOn == HoverOnAll {
+		return nil
+	}
+	validFlags := map[string]bool{
+		HoverOnViolins: true,
+		HoverOnPoints:  true,
+		HoverOnKDE:     true,
+	}
+	return validateFlagList("HoverOn", "hover on value", v.HoverOn, validFlags)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (v *Violin) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&v.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type
+	m["type"] = "violin"
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("x", v.X)
+	addIfNotEmpty("y", v.Y)
+
+	// Violin specific properties
+	if v.Orientation != "" {
+		m["orientation"] = v.Orientation
+	}
+	if v.Bandwidth != nil {
+		m["bandwidth"] = *v.Bandwidth
+	}
+	if v.Side != "" {
+		m["side"] = v.Side
+	}
+	if v.Span != nil {
+		m["span"] = v.Span
+	}
+	if v.SpanMode != "" {
+		m["spanmode"] = v.SpanMode
+	}
+	if v.ScaleGroup != "" {
+		m["scalegroup"] = v.ScaleGroup
+	}
+	if v.ScaleMode != "" {
+		m["scalemode"] = v.ScaleMode
+	}
+	if v.Width != 0 {
+		m["width"] = v.Width
+	}
+	if v.QuartileMethod != "" {
+		m["quartilemethod"] = v.QuartileMethod
+	}
+
+	// Box, Mean Line and Points
+	if v.Box != nil {
+		m["box"] = v.Box
+	}
+	if v.MeanLine != nil {
+		m["meanline"] = v.MeanLine
+	}
+	if v.Points != "" {
+		m["points"] = v.Points
+	}
+	if v.Jitter != 0 {
+		m["jitter"] = v.Jitter
+	}
+	if v.PointPos != 0 {
+		m["pointpos"] = v.PointPos
+	}
+
+	// Visual Properties
+	if v.Marker != nil {
+		m["marker"] = v.Marker
+	}
+	if v.Line != nil {
+		m["line"] = v.Line
+	}
+	addIfNotEmpty("fillcolor", v.FillColor)
+	if v.Selected != nil {
+		m["selected"] = v.Selected
+	}
+	if v.Unselected != nil {
+		m["unselected"] = v.Unselected
+	}
+
+	// Hover and Text Properties
+	addIfNotEmpty("text", v.Text)
+	addIfNotEmpty("hovertext", v.HoverText)
+	if v.HoverTemplate != "" {
+		m["hovertemplate"] = v.HoverTemplate
+	}
+	if v.HoverLabel != nil {
+		m["hoverlabel"] = v.HoverLabel
+	}
+	if v.HoverOn != "" {
+		m["hoveron"] = v.HoverOn
+	}
+
+	// Layout Properties
+	if v.AlignmentGroup != "" {
+		m["alignmentgroup"] = v.AlignmentGroup
+	}
+	if v.OffsetGroup != "" {
+		m["offsetgroup"] = v.OffsetGroup
+	}
+	if v.XAxis != "" {
+		m["xaxis"] = v.XAxis
+	}
+	if v.YAxis != "" {
+		m["yaxis"] = v.YAxis
+	}
+	if v.LegendGroup != "" {
+		m["legendgroup"] = v.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
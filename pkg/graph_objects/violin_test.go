@@ -0,0 +1,188 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViolinValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Violin)
+		expectedError string
+	}{
+		{
+			name:          "valid violin",
+			setup:         func(v *Violin) {},
+			expectedError: "",
+		},
+		{
+			name: "missing x and y",
+			setup: func(v *Violin) {
+				v.Y = nil
+			},
+			expectedError: "at least one of X or Y must be provided",
+		},
+		{
+			name: "invalid orientation",
+			setup: func(v *Violin) {
+				v.Orientation = "diagonal"
+			},
+			expectedError: "invalid orientation: diagonal",
+		},
+		{
+			name: "invalid points",
+			setup: func(v *Violin) {
+				v.Points = "some"
+			},
+			expectedError: "invalid points: some",
+		},
+		{
+			name: "jitter out of range",
+			setup: func(v *Violin) {
+				v.Points = BoxPointsAll
+				v.Jitter = 1.5
+			},
+			expectedError: "jitter width must be between 0 and 1",
+		},
+		{
+			name: "point position out of range",
+			setup: func(v *Violin) {
+				v.PointPos = -3
+			},
+			expectedError: "point position must be between -2 and 2",
+		},
+		{
+			name: "invalid quartile method",
+			setup: func(v *Violin) {
+				v.QuartileMethod = "median"
+			},
+			expectedError: "invalid quartile method: median",
+		},
+		{
+			name: "non-positive bandwidth",
+			setup: func(v *Violin) {
+				v.Bandwidth = Float64(0)
+			},
+			expectedError: "bandwidth must be positive",
+		},
+		{
+			name: "invalid side",
+			setup: func(v *Violin) {
+				v.Side = "left"
+			},
+			expectedError: "invalid side: left",
+		},
+		{
+			name: "manual span",
+			setup: func(v *Violin) {
+				v.SpanMode = SpanModeManual
+				v.Span = []interface{}{0, 10}
+			},
+			expectedError: "",
+		},
+		{
+			name: "manual span without range",
+			setup: func(v *Violin) {
+				v.SpanMode = SpanModeManual
+			},
+			expectedError: "manual span mode requires a [min, max] span",
+		},
+		{
+			name: "reversed span",
+			setup: func(v *Violin) {
+				v.Span = []interface{}{10, 0}
+			},
+			expectedError: "span minimum must be less than maximum",
+		},
+		{
+			name: "invalid scale mode",
+			setup: func(v *Violin) {
+				v.ScaleMode = "area"
+			},
+			expectedError: "invalid scale mode: area",
+		},
+		{
+			name: "box width out of range",
+			setup: func(v *Violin) {
+				v.Box = &ViolinBox{Visible: Bool(true), Width: 1.5}
+			},
+			expectedError: "box width must be between 0 and 1",
+		},
+		{
+			name: "negative mean line width",
+			setup: func(v *Violin) {
+				v.MeanLine = &ViolinMeanLine{Visible: Bool(true), Width: -1}
+			},
+			expectedError: "mean line width must be non-negative",
+		},
+		{
+			name: "invalid marker opacity",
+			setup: func(v *Violin) {
+				v.Marker = &BoxMarker{Opacity: 1.5}
+			},
+			expectedError: "opacity must be between 0 and 1",
+		},
+		{
+			name: "hover on flags",
+			setup: func(v *Violin) {
+				v.HoverOn = "violins+points+kde"
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid hover on flag",
+			setup: func(v *Violin) {
+				v.HoverOn = "violins+boxes"
+			},
+			expectedError: "invalid hover on value: boxes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewViolin()
+			v.Y = []float64{1, 2, 2, 3, 3, 3, 4, 4, 5}
+			tt.setup(v)
+
+			err := v.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestViolinMarshalJSON(t *testing.T) {
+	v := NewViolin()
+	v.Y = []float64{1, 2, 3}
+	v.Side = ViolinSidePositive
+	v.ScaleGroup = "latency"
+	v.ScaleMode = ScaleModeCount
+	v.Bandwidth = Float64(0.5)
+	v.Box = &ViolinBox{Visible: Bool(true), Width: 0.2}
+	v.MeanLine = &ViolinMeanLine{Visible: Bool(true)}
+	v.Points = BoxPointsAll
+	v.Jitter = 0.3
+
+	data, err := json.Marshal(v)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"violin"`)
+	assert.Contains(t, jsonStr, `"y":[1,2,3]`)
+	assert.Contains(t, jsonStr, `"side":"positive"`)
+	assert.Contains(t, jsonStr, `"scalegroup":"latency"`)
+	assert.Contains(t, jsonStr, `"scalemode":"count"`)
+	assert.Contains(t, jsonStr, `"bandwidth":0.5`)
+	assert.Contains(t, jsonStr, `"box":{"visible":true,"width":0.2}`)
+	assert.Contains(t, jsonStr, `"meanline":{"visible":true}`)
+	assert.Contains(t, jsonStr, `"points":"all"`)
+	assert.Contains(t, jsonStr, `"jitter":0.3`)
+	assert.NotContains(t, jsonStr, `"span"`)
+}
@@ -0,0 +1,300 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ekinolik/go-plotly/pkg/validation"
+)
+
+// Waterfall measures
+const (
+	MeasureRelative = "relative"
+	MeasureTotal    = "total"
+	MeasureAbsolute = "absolute"
+)
+
+// Waterfall text info flags, combined with "+", e.g. "label+delta"
+const (
+	WaterfallTextInfoLabel   = "label"
+	WaterfallTextInfoText    = "text"
+	WaterfallTextInfoInitial = "initial"
+	WaterfallTextInfoDelta   = "delta"
+	WaterfallTextInfoFinal   = "final"
+)
+
+// Connector modes
+const (
+	ConnectorModeSpanning = "spanning"
+	ConnectorModeBetween  = "between"
+)
+
+// Waterfall represents a waterfall trace, which draws the running total of
+// relative changes as floating bars
+type Waterfall struct {
+	BaseTrace
+	// Data
+	X              interface{} `json:"x,omitempty"`
+	Y              interface{} `json:"y,omitempty"`
+	Measure        []string    `json:"measure,omitempty"` // "relative", "total" or "absolute" per bar
+	Base           float64     `json:"base,omitempty"`
+	Orientation    string      `json:"orientation,omitempty"`
+	Width          interface{} `json:"width,omitempty"` // number or array
+	Offset         interface{} `json:"offset,omitempty"`
+	OffsetGroup    string      `json:"offsetgroup,omitempty"`
+	AlignmentGroup string      `json:"alignmentgroup,omitempty"`
+
+	// Styling
+	Connector  *Connector          `json:"connector,omitempty"`
+	Increasing *WaterfallDirection `json:"increasing,omitempty"`
+	Decreasing *WaterfallDirection `json:"decreasing,omitempty"`
+	Totals     *WaterfallDirection `json:"totals,omitempty"`
+
+	// Text and Hover Properties
+	Text          interface{} `json:"text,omitempty"`
+	TextInfo      string      `json:"textinfo,omitempty"`     // flags such as "label+delta", or "none"
+	TextPosition  interface{} `json:"textposition,omitempty"` // string or array
+	TextTemplate  string      `json:"texttemplate,omitempty"`
+	TextFont      *Font       `json:"textfont,omitempty"`
+	HoverText     interface{} `json:"hovertext,omitempty"`
+	HoverTemplate string      `json:"hovertemplate,omitempty"`
+	HoverLabel    *HoverLabel `json:"hoverlabel,omitempty"`
+
+	// Layout Properties
+	XAxis       string `json:"xaxis,omitempty"`
+	YAxis       string `json:"yaxis,omitempty"`
+	LegendGroup string `json:"legendgroup,omitempty"`
+}
+
+// WaterfallDirection represents the styling of increasing, decreasing or
+// total bars of a waterfall trace
+type WaterfallDirection struct {
+	Marker *BarMarker `json:"marker,omitempty"`
+}
+
+// Connector represents the lines connecting the bars of waterfall and funnel
+// traces
+type Connector struct {
+	Visible   *bool          `json:"visible,omitempty"`
+	Mode      string         `json:"mode,omitempty"`      // waterfall only: "spanning" or "between"
+	FillColor interface{}    `json:"fillcolor,omitempty"` // funnel only
+	Line      *ConnectorLine `json:"line,omitempty"`
+}
+
+// ConnectorLine represents the line properties of a connector
+type ConnectorLine struct {
+	Color interface{} `json:"color,omitempty"`
+	Width float64     `json:"width,omitempty"`
+	Dash  string      `json:"dash,omitempty"`
+}
+
+// NewWaterfall creates a new waterfall trace
+func NewWaterfall() *Waterfall {
+	return &Waterfall{
+		BaseTrace: BaseTrace{
+			Type: "waterfall",
+		},
+	}
+}
+
+// Validate implements the Validator interface
+func (w *Waterfall) Validate() error {
+	if err := w.BaseTrace.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateBarOrientation(w.Orientation); err != nil {
+		return err
+	}
+
+	// Validate that X or Y is present
+	if w.X == nil && w.Y == nil {
+		return &validation.ValidationError{
+			Field:   "X/Y",
+			Message: "at least one of X or Y must be provided",
+		}
+	}
+
+	// Validate measures against the values of the bars
+	values := w.Y
+	if w.Orientation == string(OrientationHorizontal) {
+		values = w.X
+	}
+	if err := validateMeasures(w.Measure, values); err != nil {
+		return err
+	}
+
+	if w.Connector != nil {
+		if err := w.Connector.validate(); err != nil {
+			return err
+		}
+	}
+
+	// Validate text info and position
+	if w.TextInfo != "" && w.TextInfo != "none" {
+		validFlags := map[string]bool{
+			WaterfallTextInfoLabel:   true,
+			WaterfallTextInfoText:    true,
+			WaterfallTextInfoInitial: true,
+			WaterfallTextInfoDelta:   true,
+			WaterfallTextInfoFinal:   true,
+		}
+		if err := validateFlagList("TextInfo", "textinfo flag", w.TextInfo, validFlags); err != nil {
+			return err
+		}
+	}
+	return validateTextPositions(w.TextPosition)
+}
+
+// validateMeasures checks that there is one valid measure per bar
+func validateMeasures(measures []string, values interface{}) error {
+	if measures == nil {
+		return nil
+	}
+	if length, ok := arrayLength(values); ok && length != len(measures) {
+		return &validation.ValidationError{
+			Field:   "Measure",
+			Message: fmt.Sprintf("%d measures given for %d values", len(measures), length),
+		}
+	}
+
+	validMeasures := map[string]bool{
+		MeasureRelative: true,
+		MeasureTotal:    true,
+		MeasureAbsolute: true,
+	}
+	for i, measure := range measures {
+		if !validMeasures[measure] {
+			return &validation.ValidationError{
+				Field:   "Measure",
+				Message: fmt.Sprintf("invalid measure at index %d: %s", i, measure),
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Connector) validate() error {
+	if c.Mode != "" && c.Mode != ConnectorModeSpanning && c.Mode != ConnectorModeBetween {
+		return &validation.ValidationError{
+			Field:   "Connector.Mode",
+			Message: fmt.Sprintf("invalid connector mode: %s", c.Mode),
+		}
+	}
+	if c.Line == nil {
+		return nil
+	}
+
+	if c.Line.Width < 0 {
+		return &validation.ValidationError{
+			Field:   "Connector.Line.Width",
+			Message: "line width must be non-negative",
+		}
+	}
+	validDash := map[string]bool{
+		DashSolid:       true,
+		DashDot:         true,
+		DashDash:        true,
+		DashLongDash:    true,
+		DashDashDot:     true,
+		DashLongDashDot: true,
+	}
+	if c.Line.Dash != "" && !validDash[c.Line.Dash] {
+		return &validation.ValidationError{
+			Field:   "Connector.Line.Dash",
+			Message: fmt.Sprintf("invalid dash pattern: %s", c.Line.Dash),
+		}
+	}
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (w *Waterfall) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{})
+
+	// Add base trace fields
+	baseData, err := json.Marshal(&w.BaseTrace)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(baseData, &m); err != nil {
+		return nil, err
+	}
+
+	// Always include type
+	m["type"] = "waterfall"
+
+	// Add optional fields if present
+	addIfNotEmpty := func(key string, value interface{}) {
+		if value != nil {
+			m[key] = value
+		}
+	}
+
+	addIfNotEmpty("x", w.X)
+	addIfNotEmpty("y", w.Y)
+	if w.Measure != nil {
+		m["measure"] = w.Measure
+	}
+	if w.Base != 0 {
+		m["base"] = w.Base
+	}
+	if w.Orientation != "" {
+		m["orientation"] = w.Orientation
+	}
+	addIfNotEmpty("width", w.Width)
+	addIfNotEmpty("offset", w.Offset)
+	if w.OffsetGroup != "" {
+		m["offsetgroup"] = w.OffsetGroup
+	}
+	if w.AlignmentGroup != "" {
+		m["alignmentgroup"] = w.AlignmentGroup
+	}
+
+	// Styling
+	if w.Connector != nil {
+		m["connector"] = w.Connector
+	}
+	if w.Increasing != nil {
+		m["increasing"] = w.Increasing
+	}
+	if w.Decreasing != nil {
+		m["decreasing"] = w.Decreasing
+	}
+	if w.Totals != nil {
+		m["totals"] = w.Totals
+	}
+
+	// Text and Hover Properties
+	addIfNotEmpty("text", w.Text)
+	if w.TextInfo != "" {
+		m["textinfo"] = w.TextInfo
+	}
+	addIfNotEmpty("textposition", w.TextPosition)
+	if w.TextTemplate != "" {
+		m["texttemplate"] = w.TextTemplate
+	}
+	if w.TextFont != nil {
+		m["textfont"] = w.TextFont
+	}
+	addIfNotEmpty("hovertext", w.HoverText)
+	if w.HoverTemplate != "" {
+		m["hovertemplate"] = w.HoverTemplate
+	}
+	if w.HoverLabel != nil {
+		m["hoverlabel"] = w.HoverLabel
+	}
+
+	// Layout Properties
+	if w.XAxis != "" {
+		m["xaxis"] = w.XAxis
+	}
+	if w.YAxis != "" {
+		m["yaxis"] = w.YAxis
+	}
+	if w.LegendGroup != "" {
+		m["legendgroup"] = w.LegendGroup
+	}
+
+	return json.Marshal(m)
+}
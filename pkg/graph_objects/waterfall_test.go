@@ -0,0 +1,127 @@
+package graph_objects
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaterfallValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(*Waterfall)
+		expectedError string
+	}{
+		{
+			name:          "valid waterfall",
+			setup:         func(w *Waterfall) {},
+			expectedError: "",
+		},
+		{
+			name: "missing x and y",
+			setup: func(w *Waterfall) {
+				w.X = nil
+				w.Y = nil
+			},
+			expectedError: "at least one of X or Y must be provided",
+		},
+		{
+			name: "invalid orientation",
+			setup: func(w *Waterfall) {
+				w.Orientation = "diagonal"
+			},
+			expectedError: "invalid orientation: diagonal",
+		},
+		{
+			name: "measures do not match values",
+			setup: func(w *Waterfall) {
+				w.Measure = []string{MeasureAbsolute, MeasureRelative}
+			},
+			expectedError: "2 measures given for 4 values",
+		},
+		{
+			name: "invalid measure",
+			setup: func(w *Waterfall) {
+				w.Measure = []string{MeasureAbsolute, "delta", MeasureRelative, MeasureTotal}
+			},
+			expectedError: "invalid measure at index 1: delta",
+		},
+		{
+			name: "horizontal measures follow x",
+			setup: func(w *Waterfall) {
+				w.Orientation = string(OrientationHorizontal)
+				w.X, w.Y = w.Y, w.X
+			},
+			expectedError: "",
+		},
+		{
+			name: "invalid connector mode",
+			setup: func(w *Waterfall) {
+				w.Connector = &Connector{Mode: "straight"}
+			},
+			expectedError: "invalid connector mode: straight",
+		},
+		{
+			name: "invalid connector dash",
+			setup: func(w *Waterfall) {
+				w.Connector = &Connector{Line: &ConnectorLine{Dash: "zigzag"}}
+			},
+			expectedError: "invalid dash pattern: zigzag",
+		},
+		{
+			name: "invalid text info flag",
+			setup: func(w *Waterfall) {
+				w.TextInfo = "delta+percent"
+			},
+			expectedError: "invalid textinfo flag: percent",
+		},
+		{
+			name: "invalid text position",
+			setup: func(w *Waterfall) {
+				w.TextPosition = "middle"
+			},
+			expectedError: "invalid text position: middle",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWaterfall()
+			w.X = []string{"Revenue", "Costs", "Taxes", "Profit"}
+			w.Y = []float64{100, -40, -15, 0}
+			w.Measure = []string{MeasureAbsolute, MeasureRelative, MeasureRelative, MeasureTotal}
+			tt.setup(w)
+
+			err := w.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestWaterfallMarshalJSON(t *testing.T) {
+	w := NewWaterfall()
+	w.X = []string{"Revenue", "Costs", "Profit"}
+	w.Y = []float64{100, -40, 0}
+	w.Measure = []string{MeasureAbsolute, MeasureRelative, MeasureTotal}
+	w.Connector = &Connector{Mode: ConnectorModeBetween, Line: &ConnectorLine{Color: "gray", Dash: DashDot}}
+	w.Decreasing = &WaterfallDirection{Marker: &BarMarker{Color: "red"}}
+	w.TextInfo = "delta"
+
+	data, err := json.Marshal(w)
+	assert.NoError(t, err)
+
+	jsonStr := string(data)
+	assert.Contains(t, jsonStr, `"type":"waterfall"`)
+	assert.Contains(t, jsonStr, `"measure":["absolute","relative","total"]`)
+	assert.Contains(t, jsonStr, `"connector":{"mode":"between","line":{"color":"gray","dash":"dot"}}`)
+	assert.Contains(t, jsonStr, `"decreasing":{"marker":{"color":"red"}}`)
+	assert.Contains(t, jsonStr, `"textinfo":"delta"`)
+	assert.NotContains(t, jsonStr, `"increasing"`)
+	assert.NotContains(t, jsonStr, `"base"`)
+}
@@ -0,0 +1,79 @@
+// Package plotlyjs provides the pinned plotly.js bundle used for offline
+// HTML output.
+package plotlyjs
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Version is the pinned plotly.js version. It must match the vendored bundle
+// and the PLOTLYJS_VERSION in the Makefile.
+const Version = "2.35.2"
+
+// EnvVar names the environment variable that supplies plotly.js when the
+// bundle is not embedded. It holds either the path of a local plotly.js
+// bundle, or "cdn" to load the pinned version from the CDN without a warning.
+const EnvVar = "GOPLOTLY_PLOTLYJS"
+
+// bundlePath is the location of the vendored bundle in the embedded assets
+const bundlePath = "assets/plotly.min.js"
+
+//go:embed assets
+var assets embed.FS
+
+// ErrNotVendored is returned when the plotly.js bundle was not vendored into
+// the assets directory before building and no local bundle is configured
+var ErrNotVendored = errors.New("plotly.js bundle is not vendored; run `make plotlyjs` to download plotly.js " + Version +
+	", set " + EnvVar + " to the path of a local bundle, or set " + EnvVar + "=cdn to use the CDN")
+
+// CDNURL returns the CDN URL of the pinned plotly.js version
+func CDNURL() string {
+	return "https://cdn.plot.ly/plotly-" + Version + ".min.js"
+}
+
+// Bundle returns the embedded plotly.js bundle, or the local bundle named by
+// EnvVar when none is embedded
+func Bundle() ([]byte, error) {
+	if data, err := assets.ReadFile(bundlePath); err == nil {
+		return data, nil
+	}
+
+	path := os.Getenv(EnvVar)
+	if path == "" || path == "cdn" {
+		return nil, ErrNotVendored
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading plotly.js bundle from %s: %v", EnvVar, err)
+	}
+	return data, nil
+}
+
+// Available reports whether a plotly.js bundle is embedded or configured
+// through EnvVar
+func Available() bool {
+	if _, err := assets.ReadFile(bundlePath); err == nil {
+		return true
+	}
+	path := os.Getenv(EnvVar)
+	return path != "" && path != "cdn"
+}
+
+// CDNAllowed reports whether EnvVar explicitly allows loading plotly.js from
+// the CDN when no bundle is available
+func CDNAllowed() bool {
+	return os.Getenv(EnvVar) == "cdn"
+}
+
+// WriteFile writes the plotly.js bundle to path, for HTML files that
+// reference plotly.js by a local path
+func WriteFile(path string) error {
+	data, err := Bundle()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
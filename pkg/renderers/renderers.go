@@ -0,0 +1,287 @@
+// Package renderers selects how figures are displayed, like plotly.io.renderers
+// in Python. Renderers are registered by name and the default is picked from
+// the GOPLOTLY_RENDERER environment variable, SetDefault, or the environment
+// the program runs in. Figure.Show dispatches through the default renderer.
+package renderers
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// EnvVar is the environment variable that selects the default renderer
+const EnvVar = "GOPLOTLY_RENDERER"
+
+// OutputDirEnvVar is the environment variable that selects the directory the
+// file renderers write to
+const OutputDirEnvVar = "GOPLOTLY_OUTPUT_DIR"
+
+// Names of the built-in renderers
+const (
+	Browser  = "browser"  // serve the figure to the default browser
+	HTML     = "html"     // write an HTML file
+	JSON     = "json"     // write the figure JSON to stdout
+	SVG      = "svg"      // write an SVG image file
+	PNG      = "png"      // write a PNG image file
+	Notebook = "notebook" // point to the notebook display path on stdout
+	None     = "none"     // do nothing, e.g. in tests
+)
+
+// Figure is a figure that can be rendered. *figure.Figure implements it.
+type Figure interface {
+	ToJSON() ([]byte, error)
+	WriteOfflineHTML(w io.Writer) error
+	WriteSVG(w io.Writer) error
+	WritePNG(w io.Writer, width, height int, scale float64) error
+	ShowInBrowser() error
+}
+
+// Renderer displays or exports a figure
+type Renderer interface {
+	Render(fig Figure) error
+}
+
+// RendererFunc adapts a function to the Renderer interface
+type RendererFunc func(fig Figure) error
+
+// Render calls f(fig)
+func (f RendererFunc) Render(fig Figure) error {
+	return f(fig)
+}
+
+var (
+	registryMu  sync.RWMutex
+	registry    = builtinRenderers()
+	defaultName string
+)
+
+func builtinRenderers() map[string]Renderer {
+	return map[string]Renderer{
+		Browser:  RendererFunc(func(fig Figure) error { return fig.ShowInBrowser() }),
+		HTML:     &FileRenderer{Format: HTML},
+		JSON:     &JSONRenderer{},
+		SVG:      &FileRenderer{Format: SVG},
+		PNG:      &FileRenderer{Format: PNG},
+		Notebook: &NotebookRenderer{},
+		None:     RendererFunc(func(fig Figure) error { return nil }),
+	}
+}
+
+// Register registers a renderer under the given name, replacing any renderer
+// of the same name
+func Register(name string, r Renderer) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = r
+}
+
+// Get returns the renderer registered under the given name
+func Get(name string) (Renderer, error) {
+	registryMu.RLock()
+	r, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown renderer %q (available: %s)", name, strings.Join(Names(), ", "))
+	}
+	return r, nil
+}
+
+// Names returns the names of the registered renderers in sorted order
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetDefault sets the renderer used when GOPLOTLY_RENDERER is not set. An
+// empty name restores the detected default.
+func SetDefault(name string) error {
+	if name != "" {
+		if _, err := Get(name); err != nil {
+			return err
+		}
+	}
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	defaultName = name
+	return nil
+}
+
+// Default returns the name of the default renderer: the GOPLOTLY_RENDERER
+// environment variable, then the name set with SetDefault, then "notebook"
+// inside a Jupyter kernel, "none" in CI and "browser" otherwise
+func Default() string {
+	if name := os.Getenv(EnvVar); name != "" {
+		return name
+	}
+
+	registryMu.RLock()
+	name := defaultName
+	registryMu.RUnlock()
+	if name != "" {
+		return name
+	}
+
+	switch {
+	case os.Getenv("JPY_PARENT_PID") != "" || os.Getenv("GONB_DIR") != "":
+		return Notebook
+	case os.Getenv("CI") != "":
+		return None
+	}
+	return Browser
+}
+
+// Render renders the figure with the default renderer
+func Render(fig Figure) error {
+	return RenderWith(Default(), fig)
+}
+
+// RenderWith renders the figure with the named renderer
+func RenderWith(name string, fig Figure) error {
+	r, err := Get(name)
+	if err != nil {
+		return err
+	}
+	return r.Render(fig)
+}
+
+// JSONRenderer writes the figure JSON
+type JSONRenderer struct {
+	Output io.Writer // os.Stdout when nil
+}
+
+// Render implements the Renderer interface
+func (r *JSONRenderer) Render(fig Figure) error {
+	data, err := fig.ToJSON()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(output(r.Output), "%s\n", data)
+	return err
+}
+
+// NotebookHint is written by the notebook renderer. Go kernels only display
+// the values of cells or data passed to their display functions, so a figure
+// cannot be displayed from within Show.
+const NotebookHint = "To display the figure in a notebook, end the cell with the figure (gophernotes) " +
+	"or pass its MIMEBundle to the kernel's display function (GoNB)"
+
+// NotebookRenderer is the renderer inside a notebook kernel. It checks that
+// the figure can be serialized and writes NotebookHint instead of opening a
+// browser on the kernel machine.
+type NotebookRenderer struct {
+	Output io.Writer // os.Stdout when nil
+}
+
+// Render implements the Renderer interface
+func (r *NotebookRenderer) Render(fig Figure) error {
+	if _, err := fig.ToJSON(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(output(r.Output), NotebookHint)
+	return err
+}
+
+// FileRenderer writes the figure to a new file and prints its path
+type FileRenderer struct {
+	// Format is the file format: "html", "svg", "png" or "json"
+	Format string
+
+	// Dir is the output directory. When empty, GOPLOTLY_OUTPUT_DIR is used,
+	// or a temporary directory shared by the process.
+	Dir string
+
+	// Width, Height and Scale are passed to WritePNG
+	Width, Height int
+	Scale         float64
+
+	Output io.Writer // os.Stdout when nil
+}
+
+// Render implements the Renderer interface
+func (r *FileRenderer) Render(fig Figure) error {
+	dir, err := r.dir()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(dir, "plot-*."+r.Format)
+	if err != nil {
+		return fmt.Errorf("error creating %s file: %v", r.Format, err)
+	}
+	err = r.write(fig, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return fmt.Errorf("error writing %s file: %v", r.Format, err)
+	}
+
+	_, err = fmt.Fprintf(output(r.Output), "Plot saved to: %s\n", file.Name())
+	return err
+}
+
+func (r *FileRenderer) write(fig Figure, w io.Writer) error {
+	switch r.Format {
+	case HTML:
+		return fig.WriteOfflineHTML(w)
+	case SVG:
+		return fig.WriteSVG(w)
+	case PNG:
+		return fig.WritePNG(w, r.Width, r.Height, r.Scale)
+	case JSON:
+		data, err := fig.ToJSON()
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+	return fmt.Errorf("unsupported file format %q", r.Format)
+}
+
+var (
+	tempDirOnce sync.Once
+	tempDir     string
+	tempDirErr  error
+)
+
+// dir returns the output directory, creating it if needed
+func (r *FileRenderer) dir() (string, error) {
+	dir := r.Dir
+	if dir == "" {
+		dir = os.Getenv(OutputDirEnvVar)
+	}
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return "", fmt.Errorf("error creating output directory: %v", err)
+		}
+		return filepath.Abs(dir)
+	}
+
+	tempDirOnce.Do(func() {
+		tempDir, tempDirErr = os.MkdirTemp("", "go-plotly-")
+	})
+	if tempDirErr != nil {
+		return "", fmt.Errorf("error creating temp directory: %v", tempDirErr)
+	}
+	return tempDir, nil
+}
+
+func output(w io.Writer) io.Writer {
+	if w == nil {
+		return os.Stdout
+	}
+	return w
+}
@@ -0,0 +1,210 @@
+package renderers
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeFigure records how it was rendered
+type fakeFigure struct {
+	shown bool
+	err   error
+}
+
+func (f *fakeFigure) ToJSON() ([]byte, error) {
+	return []byte(`{"data":[{"type":"scatter"}]}`), f.err
+}
+
+func (f *fakeFigure) WriteOfflineHTML(w io.Writer) error {
+	if f.err != nil {
+		return f.err
+	}
+	_, err := io.WriteString(w, "<html></html>")
+	return err
+}
+
+func (f *fakeFigure) WriteSVG(w io.Writer) error {
+	if f.err != nil {
+		return f.err
+	}
+	_, err := io.WriteString(w, "<svg></svg>")
+	return err
+}
+
+func (f *fakeFigure) WritePNG(w io.Writer, width, height int, scale float64) error {
+	if f.err != nil {
+		return f.err
+	}
+	_, err := io.WriteString(w, "png")
+	return err
+}
+
+func (f *fakeFigure) ShowInBrowser() error {
+	f.shown = true
+	return f.err
+}
+
+// clearEnv unsets the variables used to detect the default renderer
+func clearEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{EnvVar, "JPY_PARENT_PID", "GONB_DIR", "CI"} {
+		t.Setenv(name, "")
+		os.Unsetenv(name)
+	}
+	t.Cleanup(func() { SetDefault("") })
+}
+
+func TestDefault(t *testing.T) {
+	tests := []struct {
+		name       string
+		env        map[string]string
+		setDefault string
+		want       string
+	}{
+		{name: "desktop", want: Browser},
+		{name: "ci", env: map[string]string{"CI": "true"}, want: None},
+		{name: "jupyter kernel", env: map[string]string{"JPY_PARENT_PID": "42", "CI": "true"}, want: Notebook},
+		{name: "gonb kernel", env: map[string]string{"GONB_DIR": "/tmp/gonb"}, want: Notebook},
+		{name: "set default", env: map[string]string{"CI": "true"}, setDefault: SVG, want: SVG},
+		{name: "environment variable", env: map[string]string{EnvVar: JSON}, setDefault: SVG, want: JSON},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearEnv(t)
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			assert.NoError(t, SetDefault(tt.setDefault))
+			assert.Equal(t, tt.want, Default())
+		})
+	}
+}
+
+func TestSetDefaultUnknown(t *testing.T) {
+	clearEnv(t)
+	err := SetDefault("unknown")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "available: browser")
+	assert.Equal(t, Browser, Default())
+}
+
+func TestRender(t *testing.T) {
+	clearEnv(t)
+
+	t.Setenv(EnvVar, Browser)
+	fig := &fakeFigure{}
+	assert.NoError(t, Render(fig))
+	assert.True(t, fig.shown)
+
+	t.Setenv(EnvVar, None)
+	fig = &fakeFigure{}
+	assert.NoError(t, Render(fig))
+	assert.False(t, fig.shown)
+
+	t.Setenv(EnvVar, "unknown")
+	err := Render(fig)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown renderer "unknown"`)
+}
+
+func TestRegister(t *testing.T) {
+	clearEnv(t)
+
+	var rendered Figure
+	Register("custom", RendererFunc(func(fig Figure) error {
+		rendered = fig
+		return nil
+	}))
+	t.Cleanup(func() {
+		registryMu.Lock()
+		delete(registry, "custom")
+		registryMu.Unlock()
+	})
+
+	assert.Contains(t, Names(), "custom")
+	fig := &fakeFigure{}
+	assert.NoError(t, RenderWith("custom", fig))
+	assert.Same(t, fig, rendered)
+}
+
+func TestJSONRenderer(t *testing.T) {
+	var output bytes.Buffer
+	assert.NoError(t, (&JSONRenderer{Output: &output}).Render(&fakeFigure{}))
+	assert.Equal(t, "{\"data\":[{\"type\":\"scatter\"}]}\n", output.String())
+
+	assert.Error(t, (&JSONRenderer{Output: &output}).Render(&fakeFigure{err: errors.New("invalid")}))
+}
+
+func TestNotebookRenderer(t *testing.T) {
+	var output bytes.Buffer
+	fig := &fakeFigure{}
+	assert.NoError(t, (&NotebookRenderer{Output: &output}).Render(fig))
+
+	// Kernels do not display JSON written to stdout, so only the hint is written
+	assert.Equal(t, NotebookHint+"\n", output.String())
+	assert.False(t, fig.shown, "notebook renderer should not open a browser")
+
+	assert.Error(t, (&NotebookRenderer{Output: &output}).Render(&fakeFigure{err: errors.New("invalid")}))
+}
+
+func TestFileRenderer(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: HTML, want: "<html></html>"},
+		{format: SVG, want: "<svg></svg>"},
+		{format: PNG, want: "png"},
+		{format: JSON, want: `{"data":[{"type":"scatter"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			dir := t.TempDir()
+			var output bytes.Buffer
+			r := &FileRenderer{Format: tt.format, Dir: dir, Output: &output}
+			assert.NoError(t, r.Render(&fakeFigure{}))
+
+			path := strings.TrimSpace(strings.TrimPrefix(output.String(), "Plot saved to: "))
+			assert.True(t, strings.HasPrefix(path, dir))
+			assert.True(t, strings.HasSuffix(path, "."+tt.format))
+
+			content, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, string(content))
+		})
+	}
+}
+
+func TestFileRendererOutputDirEnvVar(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(OutputDirEnvVar, dir)
+
+	var output bytes.Buffer
+	assert.NoError(t, (&FileRenderer{Format: SVG, Output: &output}).Render(&fakeFigure{}))
+	assert.Contains(t, output.String(), dir)
+}
+
+func TestFileRendererErrors(t *testing.T) {
+	dir := t.TempDir()
+	var output bytes.Buffer
+
+	err := (&FileRenderer{Format: SVG, Dir: dir, Output: &output}).Render(&fakeFigure{err: errors.New("unsupported trace")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported trace")
+
+	err = (&FileRenderer{Format: "pdf", Dir: dir, Output: &output}).Render(&fakeFigure{})
+	assert.Error(t, err)
+
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries, "failed renders should not leave files behind")
+	assert.Empty(t, output.String())
+}